@@ -0,0 +1,217 @@
+package emailaddress
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// BatchOptions configure ValidateBatch and ValidateSlice
+type BatchOptions struct {
+	// Concurrency is the number of workers, runtime.NumCPU() is used when it is not positive
+	Concurrency int
+	// Profile the addresses are validated against, ProfileRFC5322 is used when it has no name
+	Profile Profile
+	// CheckMX look up the MX record of the domain of every valid address, the outcome is recorded as check mx
+	CheckMX bool
+	// DomainInterval is the minimum interval between two DNS lookups of the same domain, zero means no limit
+	DomainInterval time.Duration
+	// Ordered keep the results in the order of the inputs, a slow address hold back the ones after it
+	Ordered bool
+}
+
+// withDefaults fill in the defaults of the zero fields
+func (opts BatchOptions) withDefaults() BatchOptions {
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = runtime.NumCPU()
+	}
+	if len(opts.Profile.Name) == 0 {
+		opts.Profile = ProfileRFC5322
+	}
+	return opts
+}
+
+// domainLimiter space the DNS lookups of the same domain by interval
+type domainLimiter struct {
+	interval time.Duration
+	lock     sync.Mutex
+	next     map[string]time.Time
+}
+
+func newDomainLimiter(interval time.Duration) *domainLimiter {
+	return &domainLimiter{
+		interval: interval,
+		next:     make(map[string]time.Time),
+	}
+}
+
+// wait until a lookup of domain is allowed, it return the error of ctx when ctx is done before that
+func (l *domainLimiter) wait(ctx context.Context, domain string) error {
+	if l.interval <= 0 {
+		return ctx.Err()
+	}
+	l.lock.Lock()
+	now := time.Now()
+	if len(l.next) > 1024 {
+		// forget the domains which are allowed already, so the map doesn't grow forever
+		for d, t := range l.next {
+			if t.Before(now) {
+				delete(l.next, d)
+			}
+		}
+	}
+	allowed := l.next[domain]
+	if allowed.Before(now) {
+		allowed = now
+	}
+	l.next[domain] = allowed.Add(l.interval)
+	l.lock.Unlock()
+
+	d := time.Until(allowed)
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// batchItem is an input or a result with its position in the inputs
+type batchItem struct {
+	idx    int
+	input  string
+	result Result
+}
+
+// validateOne validate input and run the checks asked by opts
+func validateOne(ctx context.Context, input string, opts BatchOptions, limiter *domainLimiter) Result {
+	e, err := ParseWithProfile(input, opts.Profile)
+	r := NewResult(input, e, err)
+	if nil != err || !opts.CheckMX {
+		return r
+	}
+	domain := e.canonicalDomain()
+	if err := limiter.wait(ctx, domain); nil != err {
+		r.AddCheck("mx", false, err.Error())
+		return r
+	}
+	if !HasDomainMXContext(ctx, domain) {
+		r.AddCheck("mx", false, domain+" has no MX record")
+		return r
+	}
+	r.AddCheck("mx", true, domain+" has MX record")
+	return r
+}
+
+// ValidateBatch validate the addresses from inputs with a pool of workers, and send the results to the returned channel.
+// The returned channel is closed when inputs is closed and all the results are sent, or when ctx is done,
+// the addresses not validated yet are dropped in that case.
+// The results are in the order of the inputs only when opts.Ordered is true
+func ValidateBatch(ctx context.Context, inputs <-chan string, opts BatchOptions) <-chan Result {
+	opts = opts.withDefaults()
+	limiter := newDomainLimiter(opts.DomainInterval)
+	jobs := make(chan batchItem)
+	results := make(chan batchItem, opts.Concurrency)
+	out := make(chan Result, opts.Concurrency)
+
+	go func() {
+		defer close(jobs)
+		for idx := 0; ; idx++ {
+			select {
+			case <-ctx.Done():
+				return
+			case input, ok := <-inputs:
+				if !ok {
+					return
+				}
+				select {
+				case jobs <- batchItem{idx: idx, input: input}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	wg := &sync.WaitGroup{}
+	for i := 0; i < opts.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				job.result = validateOne(ctx, job.input, opts, limiter)
+				select {
+				case results <- job:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	go func() {
+		defer close(out)
+		pending := make(map[int]Result)
+		next := 0
+		for item := range results {
+			if !opts.Ordered {
+				select {
+				case out <- item.result:
+				case <-ctx.Done():
+					return
+				}
+				continue
+			}
+			pending[item.idx] = item.result
+			for {
+				r, ok := pending[next]
+				if !ok {
+					break
+				}
+				delete(pending, next)
+				next++
+				select {
+				case out <- r:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
+
+// ValidateSlice validate the addresses with a pool of workers, results[i] is the result of inputs[i].
+// When ctx is done before all the addresses are validated, the results of the leading addresses are returned with the error of ctx
+func ValidateSlice(ctx context.Context, inputs []string, opts BatchOptions) ([]Result, error) {
+	in := make(chan string)
+	go func() {
+		defer close(in)
+		for _, input := range inputs {
+			select {
+			case in <- input:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	opts.Ordered = true
+	results := make([]Result, 0, len(inputs))
+	for r := range ValidateBatch(ctx, in, opts) {
+		results = append(results, r)
+	}
+	if len(results) < len(inputs) {
+		return results, ctx.Err()
+	}
+	return results, nil
+}
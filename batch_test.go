@@ -0,0 +1,177 @@
+package emailaddress
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+// stubMX replace lookupMX, domains in withMX have MX records, and every lookup is recorded
+func stubMX(t *testing.T, delay time.Duration, withMX ...string) *mxRecorder {
+	origin := lookupMX
+	t.Cleanup(func() {
+		lookupMX = origin
+	})
+	recorder := &mxRecorder{}
+	lookupMX = func(ctx context.Context, domain string) ([]*net.MX, error) {
+		recorder.record(domain)
+		time.Sleep(delay)
+		for _, d := range withMX {
+			if d == domain {
+				return []*net.MX{{Host: "mx." + domain, Pref: 10}}, nil
+			}
+		}
+		return nil, fmt.Errorf("no such host")
+	}
+	return recorder
+}
+
+type mxRecorder struct {
+	lock  sync.Mutex
+	times map[string][]time.Time
+}
+
+func (r *mxRecorder) record(domain string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if nil == r.times {
+		r.times = make(map[string][]time.Time)
+	}
+	r.times[domain] = append(r.times[domain], time.Now())
+}
+
+func TestValidateSlice(t *testing.T) {
+	stubMX(t, time.Millisecond, "test.net")
+	inputs := []string{"a@test.net", "b@", "c@example.com", "(comment)d@test.net", "e@test.net"}
+	results, err := ValidateSlice(context.Background(), inputs, BatchOptions{
+		Concurrency: 3,
+		Profile:     ProfileRFC5321,
+		CheckMX:     true,
+	})
+	if nil != err {
+		t.Errorf("we are not expecting error , however we got:%s", err)
+		t.FailNow()
+	}
+	expected := []struct {
+		valid  bool
+		passed bool
+	}{
+		{true, true},
+		{false, false},
+		{true, false},
+		{false, false},
+		{true, true},
+	}
+	if len(results) != len(inputs) {
+		t.Errorf("we are expecting %d results, however we got %d", len(inputs), len(results))
+		t.FailNow()
+	}
+	for idx, r := range results {
+		if r.Input != inputs[idx] {
+			t.Errorf("we are expecting result of %s, however we got %s", inputs[idx], r.Input)
+		}
+		if r.Valid != expected[idx].valid || r.Passed() != expected[idx].passed {
+			t.Errorf("%s: we are expecting valid %t passed %t, however we got %+v", r.Input, expected[idx].valid, expected[idx].passed, r)
+		}
+	}
+}
+
+func TestValidateBatchUnordered(t *testing.T) {
+	in := make(chan string)
+	go func() {
+		defer close(in)
+		for i := 0; i < 100; i++ {
+			in <- fmt.Sprintf("user%d@test.net", i)
+		}
+	}()
+	var inputs []string
+	for r := range ValidateBatch(context.Background(), in, BatchOptions{Concurrency: 8}) {
+		if !r.Valid {
+			t.Errorf("we are expecting %s to be valid, however we got %s", r.Input, r.Error)
+		}
+		inputs = append(inputs, r.Input)
+	}
+	if len(inputs) != 100 {
+		t.Errorf("we are expecting 100 results, however we got %d", len(inputs))
+	}
+	sort.Strings(inputs)
+	for i := 1; i < len(inputs); i++ {
+		if inputs[i] == inputs[i-1] {
+			t.Errorf("we are not expecting duplicated result %s", inputs[i])
+		}
+	}
+}
+
+func TestValidateBatchDomainInterval(t *testing.T) {
+	recorder := stubMX(t, 0, "test.net", "example.com")
+	interval := 30 * time.Millisecond
+	inputs := []string{"a@test.net", "b@Test.net", "c@example.com", "d@test.net"}
+	if _, err := ValidateSlice(context.Background(), inputs, BatchOptions{
+		Concurrency:    4,
+		CheckMX:        true,
+		DomainInterval: interval,
+	}); nil != err {
+		t.Errorf("we are not expecting error , however we got:%s", err)
+		t.FailNow()
+	}
+	times := recorder.times["test.net"]
+	if len(times) != 3 {
+		t.Errorf("we are expecting 3 lookups of test.net, however we got %d", len(times))
+		t.FailNow()
+	}
+	sort.Slice(times, func(i, j int) bool {
+		return times[i].Before(times[j])
+	})
+	for i := 1; i < len(times); i++ {
+		// allow some slack for the timer
+		if d := times[i].Sub(times[i-1]); d < interval-5*time.Millisecond {
+			t.Errorf("we are expecting the lookups to be %s apart, however they are %s apart", interval, d)
+		}
+	}
+}
+
+func TestValidateBatchCancel(t *testing.T) {
+	stubMX(t, 0, "test.net")
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan string)
+	out := ValidateBatch(ctx, in, BatchOptions{
+		Concurrency:    2,
+		CheckMX:        true,
+		DomainInterval: time.Hour,
+	})
+	in <- "a@test.net"
+	if r := <-out; !r.Passed() {
+		t.Errorf("we are expecting the first address to pass, however we got %+v", r)
+	}
+	in <- "b@test.net"
+	cancel()
+	select {
+	case _, ok := <-out:
+		if ok {
+			// the result of b could be sent, as the cancellation is racing with it
+			if _, ok := <-out; ok {
+				t.Errorf("we are expecting the output to be closed")
+			}
+		}
+	case <-time.After(time.Second):
+		t.Errorf("we are expecting the output to be closed after cancellation")
+	}
+
+	inputs := make([]string, 1000)
+	for i := range inputs {
+		inputs[i] = "a@test.net"
+	}
+	cctx, ccancel := context.WithCancel(context.Background())
+	ccancel()
+	results, err := ValidateSlice(cctx, inputs, BatchOptions{})
+	if err != context.Canceled {
+		t.Errorf("we are expecting context.Canceled, however we got %v", err)
+	}
+	if len(results) >= len(inputs) {
+		t.Errorf("we are expecting part of the results, however we got %d", len(results))
+	}
+}
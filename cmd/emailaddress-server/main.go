@@ -0,0 +1,84 @@
+// Command emailaddress-server serve the validation of email addresses over HTTP, see package httpapi for the endpoints,
+// and over gRPC when -grpc-addr is given, see package grpcapi for the service.
+//
+//	emailaddress-server -addr :8080 -grpc-addr :9090 -max-batch 1000
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/johnnyluo/emailaddress/grpcapi"
+	"github.com/johnnyluo/emailaddress/httpapi"
+	"google.golang.org/grpc"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	grpcAddr := flag.String("grpc-addr", "", "address to serve gRPC on, gRPC is disabled when it is empty")
+	maxBody := flag.Int64("max-body", 1<<20, "maximum size of a request body in bytes")
+	maxBatch := flag.Int("max-batch", 1000, "maximum number of addresses in a batch request")
+	concurrency := flag.Int("concurrency", 0, "number of workers validating a batch, the number of CPUs when it is 0")
+	domainInterval := flag.Duration("domain-interval", 0, "minimum interval between two MX lookups of the same domain in a batch")
+	timeout := flag.Duration("timeout", 30*time.Second, "maximum duration to serve a request")
+	flag.Parse()
+
+	handler := httpapi.NewHandler(httpapi.Config{
+		MaxBodyBytes:   *maxBody,
+		MaxBatchSize:   *maxBatch,
+		Concurrency:    *concurrency,
+		DomainInterval: *domainInterval,
+	})
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           http.TimeoutHandler(handler, *timeout, `{"error":"timeout"}`),
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       *timeout,
+		WriteTimeout:      *timeout + 5*time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	var grpcServer *grpc.Server
+	if len(*grpcAddr) > 0 {
+		lis, err := net.Listen("tcp", *grpcAddr)
+		if nil != err {
+			log.Fatal(err)
+		}
+		grpcServer = grpc.NewServer()
+		grpcapi.RegisterEmailAddressServiceServer(grpcServer, grpcapi.NewServer())
+		go func() {
+			log.Printf("serving gRPC on %s", *grpcAddr)
+			if err := grpcServer.Serve(lis); nil != err {
+				log.Fatal(err)
+			}
+		}()
+	}
+	// done is closed when the servers have drained, main waits for it, as ListenAndServe return as soon as Shutdown starts
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		<-ctx.Done()
+		if nil != grpcServer {
+			grpcServer.GracefulStop()
+		}
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); nil != err {
+			log.Printf("fail to shutdown: %s", err)
+		}
+	}()
+	log.Printf("listening on %s", *addr)
+	if err := server.ListenAndServe(); nil != err && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatal(err)
+	}
+	<-done
+}
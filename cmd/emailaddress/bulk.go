@@ -0,0 +1,312 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/johnnyluo/emailaddress"
+)
+
+// bulkColumns are the columns bulk add to every row
+var bulkColumns = []string{"emailaddress_valid", "emailaddress_error_code", "emailaddress_error", "emailaddress_canonical"}
+
+// bulkSummary count the outcome of the rows
+type bulkSummary struct {
+	total      int
+	valid      int
+	errorCodes map[string]int
+	domains    map[string]int
+}
+
+func newBulkSummary() *bulkSummary {
+	return &bulkSummary{
+		errorCodes: make(map[string]int),
+		domains:    make(map[string]int),
+	}
+}
+
+func (s *bulkSummary) add(r emailaddress.Result) {
+	s.total++
+	if !r.Valid {
+		s.errorCodes[string(r.ErrorCode)]++
+		return
+	}
+	s.valid++
+	s.domains[strings.TrimSuffix(strings.ToLower(r.Domain), ".")]++
+}
+
+func (s *bulkSummary) print(w io.Writer, top int) {
+	fmt.Fprintf(w, "total: %d, valid: %d, invalid: %d\n", s.total, s.valid, s.total-s.valid)
+	printTop(w, "top error codes", s.errorCodes, top)
+	printTop(w, "top domains", s.domains, top)
+}
+
+// printTop print the top n entries of counts, the entries of the same count are sorted by name
+func printTop(w io.Writer, title string, counts map[string]int, n int) {
+	if len(counts) == 0 || n <= 0 {
+		return
+	}
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if counts[names[i]] != counts[names[j]] {
+			return counts[names[i]] > counts[names[j]]
+		}
+		return names[i] < names[j]
+	})
+	if len(names) > n {
+		names = names[:n]
+	}
+	fmt.Fprintf(w, "%s:\n", title)
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-30s %d\n", name, counts[name])
+	}
+}
+
+// bulkValues return the values of the columns bulk add for r
+func bulkValues(r emailaddress.Result) []string {
+	return []string{strconv.FormatBool(r.Valid), string(r.ErrorCode), r.Error, r.Canonical}
+}
+
+// guessInputFormat guess the format from the extension of the file, csv is the default
+func guessInputFormat(name string) string {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".tsv", ".tab":
+		return "tsv"
+	case ".jsonl", ".ndjson":
+		return "jsonl"
+	}
+	return "csv"
+}
+
+// runBulk validate the address column of every row in a file,
+// write the rows back with the validation columns added, and print a summary to stderr
+func runBulk(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	fs := flag.NewFlagSet("bulk", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	in := fs.String("in", "", "input file, standard input when it is empty")
+	out := fs.String("out", "", "output file, standard output when it is empty")
+	inputFormat := fs.String("input-format", "", "csv, tsv or jsonl, guessed from the extension of -in when it is empty")
+	column := fs.String("column", "email", "the address column of csv and tsv, or the dot separated path of jsonl, e.g. user.email")
+	profileName := fs.String("profile", emailaddress.ProfileRFC5322.Name, "validation profile: "+strings.Join(emailaddress.ProfileNames(), ", "))
+	top := fs.Int("top", 10, "number of top error codes and domains in the summary")
+	if err := fs.Parse(args); nil != err {
+		if err == flag.ErrHelp {
+			return exitValid
+		}
+		return exitUsage
+	}
+	profile, ok := emailaddress.LookupProfile(*profileName)
+	if !ok {
+		fmt.Fprintf(stderr, "unknown profile %s, available profiles are %s\n", *profileName, strings.Join(emailaddress.ProfileNames(), ", "))
+		return exitUsage
+	}
+	format := *inputFormat
+	if len(format) == 0 {
+		format = guessInputFormat(*in)
+	}
+	if format != "csv" && format != "tsv" && format != "jsonl" {
+		fmt.Fprintf(stderr, "unknown input format %s, available formats are csv, tsv and jsonl\n", format)
+		return exitUsage
+	}
+
+	r := stdin
+	if len(*in) > 0 {
+		f, err := os.Open(*in)
+		if nil != err {
+			fmt.Fprintln(stderr, err)
+			return exitUsage
+		}
+		defer f.Close()
+		r = f
+	}
+	w := stdout
+	if len(*out) > 0 {
+		f, err := os.Create(*out)
+		if nil != err {
+			fmt.Fprintln(stderr, err)
+			return exitUsage
+		}
+		defer f.Close()
+		w = f
+	}
+	bw := bufio.NewWriter(w)
+
+	summary := newBulkSummary()
+	validate := func(value string) emailaddress.Result {
+		e, err := emailaddress.ParseWithProfile(value, profile)
+		result := emailaddress.NewResult(value, e, err)
+		summary.add(result)
+		return result
+	}
+	var err error
+	switch format {
+	case "jsonl":
+		err = bulkJSONL(r, bw, *column, validate)
+	case "tsv":
+		err = bulkCSV(r, bw, '\t', *column, validate)
+	default:
+		err = bulkCSV(r, bw, ',', *column, validate)
+	}
+	if nil == err {
+		err = bw.Flush()
+	}
+	if nil != err {
+		fmt.Fprintln(stderr, err)
+		return exitUsage
+	}
+	summary.print(stderr, *top)
+	if summary.valid < summary.total {
+		return exitInvalid
+	}
+	return exitValid
+}
+
+// bulkCSV validate the column of every row, the first row should be the header
+func bulkCSV(r io.Reader, w io.Writer, comma rune, column string, validate func(string) emailaddress.Result) error {
+	cr := csv.NewReader(r)
+	cr.Comma = comma
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = comma == '\t'
+	cw := csv.NewWriter(w)
+	cw.Comma = comma
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return fmt.Errorf("the input is empty")
+	}
+	if nil != err {
+		return err
+	}
+	idx := -1
+	for i, name := range header {
+		if name == column {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		return fmt.Errorf("column %s is not found in the header", column)
+	}
+	for _, name := range bulkColumns {
+		if slices.Contains(header, name) {
+			return fmt.Errorf("the header already has column %s, the input may be the output of bulk", name)
+		}
+	}
+	if err := cw.Write(append(header, bulkColumns...)); nil != err {
+		return err
+	}
+	for {
+		row, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if nil != err {
+			return err
+		}
+		if len(row) > len(header) {
+			line, _ := cr.FieldPos(0)
+			return fmt.Errorf("line %d has %d fields, however the header has %d", line, len(row), len(header))
+		}
+		value := ""
+		if idx < len(row) {
+			value = strings.TrimSpace(row[idx])
+		}
+		// a short row is padded, so the validation columns stay under their headers
+		for len(row) < len(header) {
+			row = append(row, "")
+		}
+		if err := cw.Write(append(row, bulkValues(validate(value))...)); nil != err {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// bulkJSONL validate the field at path of every JSON object, one object per line,
+// the validation fields are appended to the object, so the rest of the line is written back untouched
+func bulkJSONL(r io.Reader, w io.Writer, path string, validate func(string) emailaddress.Result) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var obj map[string]interface{}
+		if err := json.Unmarshal(line, &obj); nil != err {
+			return fmt.Errorf("line %d is not a JSON object: %w", lineNumber, err)
+		}
+		if nil == obj {
+			return fmt.Errorf("line %d is not a JSON object", lineNumber)
+		}
+		for _, name := range bulkColumns {
+			if _, ok := obj[name]; ok {
+				return fmt.Errorf("line %d already has field %s, the input may be the output of bulk", lineNumber, name)
+			}
+		}
+		value, _ := lookupJSONPath(obj, path).(string)
+		result := validate(strings.TrimSpace(value))
+
+		buf := bytes.NewBuffer(make([]byte, 0, len(line)+128))
+		buf.Write(line[:len(line)-1])
+		if len(bytes.TrimSpace(line[1:len(line)-1])) > 0 {
+			buf.WriteByte(',')
+		}
+		for idx, v := range bulkValues(result) {
+			if idx > 0 {
+				buf.WriteByte(',')
+			}
+			name, _ := json.Marshal(bulkColumns[idx])
+			buf.Write(name)
+			buf.WriteByte(':')
+			if idx == 0 {
+				buf.WriteString(v)
+				continue
+			}
+			encoded, _ := json.Marshal(v)
+			buf.Write(encoded)
+		}
+		buf.WriteString("}\n")
+		if _, err := w.Write(buf.Bytes()); nil != err {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// lookupJSONPath follow the dot separated path in a decoded JSON value, numeric segments index arrays.
+// it return nil when the path doesn't exist
+func lookupJSONPath(v interface{}, path string) interface{} {
+	for _, segment := range strings.Split(path, ".") {
+		switch node := v.(type) {
+		case map[string]interface{}:
+			v = node[segment]
+		case []interface{}:
+			idx, err := strconv.Atoi(segment)
+			if nil != err || idx < 0 || idx >= len(node) {
+				return nil
+			}
+			v = node[idx]
+		default:
+			return nil
+		}
+	}
+	return v
+}
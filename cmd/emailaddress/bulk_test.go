@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestBulk(t *testing.T) {
+	cases := []struct {
+		name            string
+		args            []string
+		stdin           string
+		expectedCode    int
+		expectedOutput  string
+		expectedSummary string
+		expectedError   string
+	}{
+		{
+			name:         "csv",
+			args:         []string{"bulk"},
+			stdin:        "id,email\n1,john@Test.net\n2,test@\n3,\"\"\"x, y\"\"@test.net\"\n4\n",
+			expectedCode: exitInvalid,
+			expectedOutput: "id,email,emailaddress_valid,emailaddress_error_code,emailaddress_error,emailaddress_canonical\n" +
+				"1,john@Test.net,true,,,john@test.net\n" +
+				"2,test@,false,empty_domain,domain part can't be empty,\n" +
+				"3,\"\"\"x, y\"\"@test.net\",true,,,\"\"\"x, y\"\"@test.net\"\n" +
+				"4,,false,empty,empty string is not valid email address,\n",
+			expectedSummary: "total: 4, valid: 2, invalid: 2\ntop error codes:\n  empty                          1\n  empty_domain                   1\ntop domains:\n  test.net                       2\n",
+		},
+		{
+			name:           "tsv",
+			args:           []string{"bulk", "-input-format", "tsv", "-column", "mail"},
+			stdin:          "mail\tname\njohn@test.net\tJohn\n",
+			expectedCode:   exitValid,
+			expectedOutput: "mail\tname\temailaddress_valid\temailaddress_error_code\temailaddress_error\temailaddress_canonical\njohn@test.net\tJohn\ttrue\t\t\tjohn@test.net\n",
+		},
+		{
+			name:         "jsonl",
+			args:         []string{"bulk", "-input-format", "jsonl", "-column", "user.emails.1"},
+			stdin:        "{\"user\":{\"emails\":[\"a@test.net\",\"b@test.net\"]}}\n\n{\"user\":{}}\n{}\n",
+			expectedCode: exitInvalid,
+			expectedOutput: "{\"user\":{\"emails\":[\"a@test.net\",\"b@test.net\"]},\"emailaddress_valid\":true,\"emailaddress_error_code\":\"\",\"emailaddress_error\":\"\",\"emailaddress_canonical\":\"b@test.net\"}\n" +
+				"{\"user\":{},\"emailaddress_valid\":false,\"emailaddress_error_code\":\"empty\",\"emailaddress_error\":\"empty string is not valid email address\",\"emailaddress_canonical\":\"\"}\n" +
+				"{\"emailaddress_valid\":false,\"emailaddress_error_code\":\"empty\",\"emailaddress_error\":\"empty string is not valid email address\",\"emailaddress_canonical\":\"\"}\n",
+		},
+		{
+			name:         "short rows",
+			args:         []string{"bulk"},
+			stdin:        "id,email,name\n4\n5,a@b.com\n",
+			expectedCode: exitInvalid,
+			expectedOutput: "id,email,name,emailaddress_valid,emailaddress_error_code,emailaddress_error,emailaddress_canonical\n" +
+				"4,,,false,empty,empty string is not valid email address,\n" +
+				"5,a@b.com,,true,,,a@b.com\n",
+		},
+		{
+			name:          "long row",
+			args:          []string{"bulk"},
+			stdin:         "email,name\njohn@test.net,John\nfoo,y,extra\n",
+			expectedCode:  exitUsage,
+			expectedError: "line 3 has 3 fields, however the header has 2",
+		},
+		{
+			name:         "csv already validated",
+			args:         []string{"bulk"},
+			stdin:        "email,emailaddress_valid\njohn@test.net,true\n",
+			expectedCode: exitUsage,
+		},
+		{
+			name:         "json null",
+			args:         []string{"bulk", "-input-format", "jsonl"},
+			stdin:        "null\n",
+			expectedCode: exitUsage,
+		},
+		{
+			name:         "json array",
+			args:         []string{"bulk", "-input-format", "jsonl"},
+			stdin:        "[1]\n",
+			expectedCode: exitUsage,
+		},
+		{
+			name:         "jsonl already validated",
+			args:         []string{"bulk", "-input-format", "jsonl"},
+			stdin:        "{\"email\":\"john@test.net\",\"emailaddress_valid\":true}\n",
+			expectedCode: exitUsage,
+		},
+		{
+			name:         "column not found",
+			args:         []string{"bulk", "-column", "mail"},
+			stdin:        "id,email\n1,john@test.net\n",
+			expectedCode: exitUsage,
+		},
+		{
+			name:         "not json",
+			args:         []string{"bulk", "-input-format", "jsonl"},
+			stdin:        "email\n",
+			expectedCode: exitUsage,
+		},
+		{
+			name:         "unknown input format",
+			args:         []string{"bulk", "-input-format", "xlsx"},
+			expectedCode: exitUsage,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			stdout := &bytes.Buffer{}
+			stderr := &bytes.Buffer{}
+			code := run(c.args, strings.NewReader(c.stdin), stdout, stderr)
+			if code != c.expectedCode {
+				st.Errorf("we are expecting exit code %d, however we got %d, stderr: %s", c.expectedCode, code, stderr)
+			}
+			if !strings.Contains(stderr.String(), c.expectedError) {
+				st.Errorf("we are expecting error %q, however we got %q", c.expectedError, stderr)
+			}
+			if c.expectedCode == exitUsage {
+				return
+			}
+			if stdout.String() != c.expectedOutput {
+				st.Errorf("we are expecting output %q, however we got %q", c.expectedOutput, stdout)
+			}
+			if len(c.expectedSummary) > 0 && stderr.String() != c.expectedSummary {
+				st.Errorf("we are expecting summary %q, however we got %q", c.expectedSummary, stderr)
+			}
+		})
+	}
+}
+
+func TestGuessInputFormat(t *testing.T) {
+	cases := map[string]string{
+		"":                 "csv",
+		"export.csv":       "csv",
+		"export.TSV":       "tsv",
+		"export.jsonl":     "jsonl",
+		"export.ndjson":    "jsonl",
+		"export.unknown":   "csv",
+		"/tmp/export.tab":  "tsv",
+		"dir.jsonl/export": "csv",
+	}
+	for name, expected := range cases {
+		if format := guessInputFormat(name); format != expected {
+			t.Errorf("%s: we are expecting %s, however we got %s", name, expected, format)
+		}
+	}
+}
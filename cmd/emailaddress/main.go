@@ -0,0 +1,282 @@
+// Command emailaddress validates, parses and normalizes email addresses.
+//
+//	emailaddress <command> [flags] [address ...]
+//
+// The addresses are read from standard input, one per line, when none is given on the command line.
+// The output is human readable text by default, -format json, jsonl or csv produce machine readable output.
+// The exit code is 0 when every address is valid, 1 when any of them is invalid, and 2 on usage error.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/johnnyluo/emailaddress"
+	"github.com/mattn/go-isatty"
+)
+
+const (
+	exitValid   = 0
+	exitInvalid = 1
+	exitUsage   = 2
+)
+
+// command is a subcommand of the CLI
+type command struct {
+	name        string
+	description string
+	// flags register the command specific flags
+	flags func(fs *flag.FlagSet, c *cli)
+	// check a valid address, and record the outcome in r
+	check func(c *cli, e *emailaddress.Address, r *record)
+	// text print r in human readable form
+	text func(c *cli, r record)
+	// run replace the default flow which handle the addresses one by one, it return the exit code
+	run func(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int
+}
+
+var commands = []command{
+	{
+		name:        "validate",
+		description: "validate email addresses",
+		text:        textValidate,
+	},
+	{
+		name:        "parse",
+		description: "print the components of email addresses",
+		text:        textParse,
+	},
+	{
+		name:        "normalize",
+		description: "print email addresses in canonical, ascii, display or key form",
+		flags: func(fs *flag.FlagSet, c *cli) {
+			fs.StringVar(&c.form, "form", string(emailaddress.FormCanonical), "output form: canonical, ascii, display, key or raw")
+		},
+		check: checkNormalize,
+		text:  textNormalize,
+	},
+	{
+		name:        "check-mx",
+		description: "check whether the domains of email addresses have MX records",
+		check:       checkMX,
+		text:        textCheckMX,
+	},
+	{
+		name:        "suggest",
+		description: "suggest corrections for mistyped popular mail domains",
+		check:       checkSuggest,
+		text:        textSuggest,
+	},
+	{
+		name:        "bulk",
+		description: "validate the address column of a csv, tsv or jsonl file",
+		run:         runBulk,
+	},
+}
+
+// cli hold the state of a run
+type cli struct {
+	stdout  io.Writer
+	stderr  io.Writer
+	profile emailaddress.Profile
+	form    string
+}
+
+func main() {
+	if os.Getenv("NO_COLOR") != "" || !isatty.IsTerminal(os.Stdout.Fd()) && !isatty.IsCygwinTerminal(os.Stdout.Fd()) {
+		color.NoColor = true
+	}
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintf(w, "usage: emailaddress <command> [flags] [address ...]\n\ncommands:\n")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-10s %s\n", cmd.name, cmd.description)
+	}
+	fmt.Fprintf(w, "\naddresses are read from standard input, one per line, when none is given.\n")
+	fmt.Fprintf(w, "run emailaddress <command> -h to see the flags of a command.\n")
+}
+
+// run the CLI and return the exit code
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return exitUsage
+	}
+	if args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		usage(stdout)
+		return exitValid
+	}
+	var cmd *command
+	for idx := range commands {
+		if commands[idx].name == args[0] {
+			cmd = &commands[idx]
+		}
+	}
+	if nil == cmd {
+		fmt.Fprintf(stderr, "unknown command %s\n\n", args[0])
+		usage(stderr)
+		return exitUsage
+	}
+
+	if nil != cmd.run {
+		return cmd.run(args[1:], stdin, stdout, stderr)
+	}
+
+	c := &cli{
+		stdout: stdout,
+		stderr: stderr,
+	}
+	fs := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	profileName := fs.String("profile", emailaddress.ProfileRFC5322.Name, "validation profile: "+strings.Join(emailaddress.ProfileNames(), ", "))
+	format := fs.String("format", formatText, "output format: "+strings.Join(formats, ", "))
+	if nil != cmd.flags {
+		cmd.flags(fs, c)
+	}
+	if err := fs.Parse(args[1:]); nil != err {
+		if err == flag.ErrHelp {
+			return exitValid
+		}
+		return exitUsage
+	}
+	profile, ok := emailaddress.LookupProfile(*profileName)
+	if !ok {
+		fmt.Fprintf(stderr, "unknown profile %s, available profiles are %s\n", *profileName, strings.Join(emailaddress.ProfileNames(), ", "))
+		return exitUsage
+	}
+	c.profile = profile
+	if cmd.name == "normalize" && !isValidForm(c.form) {
+		fmt.Fprintf(stderr, "unknown form %s, available forms are canonical, ascii, display, key and raw\n", c.form)
+		return exitUsage
+	}
+	out, err := newOutput(*format, c, cmd)
+	if nil != err {
+		fmt.Fprintln(stderr, err)
+		return exitUsage
+	}
+
+	status := exitValid
+	handle := func(input string) error {
+		e, err := emailaddress.ParseWithProfile(input, c.profile)
+		r := record{
+			Result: emailaddress.NewResult(input, e, err),
+		}
+		if nil == err && nil != cmd.check {
+			cmd.check(c, e, &r)
+		}
+		if !r.Passed() {
+			status = exitInvalid
+		}
+		return out.write(r)
+	}
+	if fs.NArg() > 0 {
+		for _, input := range fs.Args() {
+			if err := handle(input); nil != err {
+				fmt.Fprintf(stderr, "fail to write output: %s\n", err)
+				return exitUsage
+			}
+		}
+	} else {
+		scanner := bufio.NewScanner(stdin)
+		for scanner.Scan() {
+			input := strings.TrimSpace(scanner.Text())
+			if len(input) == 0 {
+				continue
+			}
+			if err := handle(input); nil != err {
+				fmt.Fprintf(stderr, "fail to write output: %s\n", err)
+				return exitUsage
+			}
+		}
+		if err := scanner.Err(); nil != err {
+			fmt.Fprintf(stderr, "fail to read standard input: %s\n", err)
+			return exitUsage
+		}
+	}
+	if err := out.close(); nil != err {
+		fmt.Fprintf(stderr, "fail to write output: %s\n", err)
+		return exitUsage
+	}
+	return status
+}
+
+func isValidForm(form string) bool {
+	for _, f := range emailaddress.Forms {
+		if string(f) == form {
+			return true
+		}
+	}
+	return false
+}
+
+// textInvalid print the reason why r is invalid
+func textInvalid(c *cli, r record) {
+	color.New(color.FgRed).Fprintf(c.stdout, "%s is invalid email address: %s\n", r.Input, r.Error)
+}
+
+func textValidate(c *cli, r record) {
+	color.New(color.FgGreen).Fprintf(c.stdout, "%s is a valid email address\n", r.Input)
+}
+
+func textParse(c *cli, r record) {
+	fmt.Fprintf(c.stdout, "input:      %s\n", r.Input)
+	fmt.Fprintf(c.stdout, "local part: %s\n", r.LocalPart)
+	if len(r.Tags) > 0 {
+		fmt.Fprintf(c.stdout, "tags:       %s\n", strings.Join(r.Tags, ", "))
+	}
+	if len(r.Comment) > 0 {
+		fmt.Fprintf(c.stdout, "comment:    %s\n", r.Comment)
+	}
+	fmt.Fprintf(c.stdout, "domain:     %s\n", r.Domain)
+	fmt.Fprintf(c.stdout, "canonical:  %s\n\n", r.Canonical)
+}
+
+func checkNormalize(c *cli, e *emailaddress.Address, r *record) {
+	r.Normalized, _ = e.Render(emailaddress.Form(c.form))
+}
+
+func textNormalize(c *cli, r record) {
+	fmt.Fprintln(c.stdout, r.Normalized)
+}
+
+func checkMX(c *cli, e *emailaddress.Address, r *record) {
+	// the domain is queried in its canonical punycode form, the same as ValidateStruct and ValidateBatch
+	domain := e.ParsedDomain().ASCII()
+	if !emailaddress.HasDomainMX(domain) {
+		r.AddCheck("mx", false, domain+" has no MX record")
+		return
+	}
+	r.AddCheck("mx", true, domain+" has MX record")
+}
+
+func textCheckMX(c *cli, r record) {
+	for _, check := range r.Checks {
+		if check.Passed {
+			color.New(color.FgGreen).Fprintf(c.stdout, "%s: %s\n", r.Input, check.Detail)
+		} else {
+			color.New(color.FgRed).Fprintf(c.stdout, "%s: %s\n", r.Input, check.Detail)
+		}
+	}
+}
+
+func checkSuggest(c *cli, e *emailaddress.Address, r *record) {
+	suggestion, err := emailaddress.Suggest(r.Input)
+	if nil == err {
+		r.Suggestion = suggestion
+	}
+}
+
+func textSuggest(c *cli, r record) {
+	if len(r.Suggestion) == 0 {
+		fmt.Fprintf(c.stdout, "%s: no suggestion\n", r.Input)
+		return
+	}
+	color.New(color.FgYellow).Fprintf(c.stdout, "%s: did you mean %s?\n", r.Input, r.Suggestion)
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+)
+
+func TestRun(t *testing.T) {
+	color.NoColor = true
+	cases := []struct {
+		name           string
+		args           []string
+		stdin          string
+		expectedCode   int
+		expectedOutput string
+	}{
+		{
+			name:         "no command",
+			args:         nil,
+			expectedCode: exitUsage,
+		},
+		{
+			name:         "unknown command",
+			args:         []string{"verify"},
+			expectedCode: exitUsage,
+		},
+		{
+			name:         "unknown flag",
+			args:         []string{"validate", "-strict"},
+			expectedCode: exitUsage,
+		},
+		{
+			name:         "unknown profile",
+			args:         []string{"validate", "-profile", "loose", "test@test.net"},
+			expectedCode: exitUsage,
+		},
+		{
+			name:         "unknown form",
+			args:         []string{"normalize", "-form", "upper", "test@test.net"},
+			expectedCode: exitUsage,
+		},
+		{
+			name:           "validate valid",
+			args:           []string{"validate", "test@test.net"},
+			expectedCode:   exitValid,
+			expectedOutput: "test@test.net is a valid email address\n",
+		},
+		{
+			name:           "validate invalid",
+			args:           []string{"validate", "test@test.net", "test@"},
+			expectedCode:   exitInvalid,
+			expectedOutput: "test@test.net is a valid email address\ntest@ is invalid email address: domain part can't be empty\n",
+		},
+		{
+			name:           "validate profile",
+			args:           []string{"validate", "-profile", "rfc5321", "test(comment)@test.net"},
+			expectedCode:   exitInvalid,
+			expectedOutput: "test(comment)@test.net is invalid email address: comment is not allowed by profile rfc5321\n",
+		},
+		{
+			name:           "validate stdin",
+			args:           []string{"validate"},
+			stdin:          "test@test.net\n\n  x@test.net  \r\nlast@test.net",
+			expectedCode:   exitValid,
+			expectedOutput: "test@test.net is a valid email address\nx@test.net is a valid email address\nlast@test.net is a valid email address\n",
+		},
+		{
+			name:           "parse",
+			args:           []string{"parse", "(comment)John+tag@Test.net"},
+			expectedCode:   exitValid,
+			expectedOutput: "input:      (comment)John+tag@Test.net\nlocal part: John\ntags:       tag\ncomment:    comment\ndomain:     Test.net\ncanonical:  John+tag@test.net\n\n",
+		},
+		{
+			name:           "normalize",
+			args:           []string{"normalize", `"john"(comment)@Test.net`},
+			expectedCode:   exitValid,
+			expectedOutput: "john@test.net\n",
+		},
+		{
+			name:           "normalize key",
+			args:           []string{"normalize", "-form", "key", `"john+tag"@Test.net`},
+			expectedCode:   exitValid,
+			expectedOutput: "john+tag@test.net\n",
+		},
+		{
+			name:         "unknown format",
+			args:         []string{"validate", "-format", "xml", "test@test.net"},
+			expectedCode: exitUsage,
+		},
+		{
+			name:           "jsonl",
+			args:           []string{"validate", "-format", "jsonl", "john+a@test.net", "test@"},
+			expectedCode:   exitInvalid,
+			expectedOutput: "{\"input\":\"john+a@test.net\",\"valid\":true,\"local_part\":\"john\",\"tags\":[\"a\"],\"domain\":\"test.net\",\"canonical\":\"john+a@test.net\"}\n{\"input\":\"test@\",\"valid\":false,\"error\":\"domain part can't be empty\",\"error_code\":\"empty_domain\",\"error_offset\":5}\n",
+		},
+		{
+			name:           "json",
+			args:           []string{"normalize", "-format", "json", "-form", "key", "John@Test.net"},
+			expectedCode:   exitValid,
+			expectedOutput: "[\n  {\n    \"input\": \"John@Test.net\",\n    \"valid\": true,\n    \"local_part\": \"John\",\n    \"domain\": \"Test.net\",\n    \"canonical\": \"John@test.net\",\n    \"normalized\": \"John@test.net\"\n  }\n]\n",
+		},
+		{
+			name:           "json without input",
+			args:           []string{"validate", "-format", "json"},
+			expectedCode:   exitValid,
+			expectedOutput: "[]\n",
+		},
+		{
+			name:           "csv",
+			args:           []string{"suggest", "-format", "csv", "john(c)@gmial.com", "john@"},
+			expectedCode:   exitInvalid,
+			expectedOutput: "input,valid,local_part,tags,comment,domain,canonical,normalized,suggestion,error,error_code,error_offset,checks,warnings\njohn(c)@gmial.com,true,john,,c,gmial.com,john@gmial.com,,john@gmail.com,,,,,cfws_comment\njohn@,false,,,,,,,,domain part can't be empty,empty_domain,5,,\n",
+		},
+		{
+			name:           "suggest",
+			args:           []string{"suggest", "john@gmial.com", "john@test.net"},
+			expectedCode:   exitValid,
+			expectedOutput: "john@gmial.com: did you mean john@gmail.com?\njohn@test.net: no suggestion\n",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			stdout := &bytes.Buffer{}
+			stderr := &bytes.Buffer{}
+			code := run(c.args, strings.NewReader(c.stdin), stdout, stderr)
+			if code != c.expectedCode {
+				st.Errorf("we are expecting exit code %d, however we got %d, stderr: %s", c.expectedCode, code, stderr)
+			}
+			if stdout.String() != c.expectedOutput {
+				st.Errorf("we are expecting output %q, however we got %q", c.expectedOutput, stdout)
+			}
+		})
+	}
+}
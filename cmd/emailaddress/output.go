@@ -0,0 +1,179 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/johnnyluo/emailaddress"
+)
+
+const (
+	formatText  = "text"
+	formatJSON  = "json"
+	formatJSONL = "jsonl"
+	formatCSV   = "csv"
+)
+
+var formats = []string{formatText, formatJSON, formatJSONL, formatCSV}
+
+// record is what the CLI output for an address
+type record struct {
+	emailaddress.Result
+	// Normalized is the address in the form asked by normalize -form
+	Normalized string `json:"normalized,omitempty"`
+	// Suggestion is the corrected address from suggest
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+// output write the records in a format
+type output interface {
+	write(r record) error
+	// close flush what is buffered
+	close() error
+}
+
+// newOutput create the output of the given format
+func newOutput(format string, c *cli, cmd *command) (output, error) {
+	switch format {
+	case formatText:
+		return &textOutput{
+			c:   c,
+			cmd: cmd,
+		}, nil
+	case formatJSON:
+		return &jsonOutput{
+			w: c.stdout,
+		}, nil
+	case formatJSONL:
+		return &jsonlOutput{
+			enc: json.NewEncoder(c.stdout),
+		}, nil
+	case formatCSV:
+		return &csvOutput{
+			w: csv.NewWriter(c.stdout),
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown format %s, available formats are %s", format, strings.Join(formats, ", "))
+}
+
+// textOutput print the records in human readable form
+type textOutput struct {
+	c   *cli
+	cmd *command
+}
+
+func (o *textOutput) write(r record) error {
+	if !r.Valid {
+		textInvalid(o.c, r)
+		return nil
+	}
+	o.cmd.text(o.c, r)
+	return nil
+}
+
+func (o *textOutput) close() error {
+	return nil
+}
+
+// jsonOutput write all the records as a JSON array when it is closed
+type jsonOutput struct {
+	w       io.Writer
+	records []record
+}
+
+func (o *jsonOutput) write(r record) error {
+	o.records = append(o.records, r)
+	return nil
+}
+
+func (o *jsonOutput) close() error {
+	if nil == o.records {
+		o.records = []record{}
+	}
+	enc := json.NewEncoder(o.w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(o.records)
+}
+
+// jsonlOutput write one JSON object per line
+type jsonlOutput struct {
+	enc *json.Encoder
+}
+
+func (o *jsonlOutput) write(r record) error {
+	return o.enc.Encode(r)
+}
+
+func (o *jsonlOutput) close() error {
+	return nil
+}
+
+// csvHeader are the columns of the csv output
+var csvHeader = []string{"input", "valid", "local_part", "tags", "comment", "domain", "canonical", "normalized", "suggestion", "error", "error_code", "error_offset", "checks", "warnings"}
+
+// csvOutput write one row per record after the header
+type csvOutput struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+func (o *csvOutput) write(r record) error {
+	if !o.headerWritten {
+		if err := o.w.Write(csvHeader); nil != err {
+			return err
+		}
+		o.headerWritten = true
+	}
+	offset := ""
+	if nil != r.ErrorOffset {
+		offset = strconv.Itoa(*r.ErrorOffset)
+	}
+	return o.w.Write([]string{
+		r.Input,
+		strconv.FormatBool(r.Valid),
+		r.LocalPart,
+		strings.Join(r.Tags, ";"),
+		r.Comment,
+		r.Domain,
+		r.Canonical,
+		r.Normalized,
+		r.Suggestion,
+		r.Error,
+		string(r.ErrorCode),
+		offset,
+		formatChecks(r.Checks),
+		formatWarnings(r.Warnings),
+	})
+}
+
+func (o *csvOutput) close() error {
+	if !o.headerWritten {
+		if err := o.w.Write(csvHeader); nil != err {
+			return err
+		}
+	}
+	o.w.Flush()
+	return o.w.Error()
+}
+
+// formatChecks format the checks as name=passed pairs separated by semicolon, e.g. mx=true
+func formatChecks(checks []emailaddress.Check) string {
+	pairs := make([]string, 0, len(checks))
+	for _, c := range checks {
+		pairs = append(pairs, c.Name+"="+strconv.FormatBool(c.Passed))
+	}
+	return strings.Join(pairs, ";")
+}
+
+// formatWarnings format the codes of the warnings separated by semicolon, e.g. cfws_comment;rfc5321_tld
+func formatWarnings(warnings []emailaddress.Warning) string {
+	codes := make([]string, 0, len(warnings))
+	for _, w := range warnings {
+		codes = append(codes, string(w.Code))
+	}
+	return strings.Join(codes, ";")
+}
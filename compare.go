@@ -0,0 +1,67 @@
+package emailaddress
+
+import "strings"
+
+// CompareMode decide how two email addresses are compared.
+// comments are never significant, and the local part is always compared in its unquoted form,
+// thus "john"@example.com and john@example.com are the same mailbox in every mode
+type CompareMode int
+
+const (
+	// CompareStrict follows RFC 5321, the local part is case-sensitive, only the domain is compared case-insensitively
+	CompareStrict CompareMode = iota
+	// CompareCaseInsensitive compares the local part case-insensitively, which is what most mail servers do in practice
+	CompareCaseInsensitive
+	// CompareCanonical compares the local part case-insensitively and disregards tags,
+	// johnny+1@test.net and Johnny+2@test.net are equal in this mode
+	CompareCanonical
+)
+
+// String stringer implementation
+func (m CompareMode) String() string {
+	switch m {
+	case CompareStrict:
+		return "strict"
+	case CompareCaseInsensitive:
+		return "case-insensitive"
+	case CompareCanonical:
+		return "canonical"
+	}
+	return "unknown"
+}
+
+// Key return a stable string identify the mailbox under CompareStrict, which is suitable for map keys and unique indexes.
+// two addresses have the same key if and only if Compare consider them equal
+func (e *Address) Key() string {
+	return e.KeyFor(CompareStrict)
+}
+
+// KeyFor return a stable string identify the mailbox under the given compare mode
+func (e *Address) KeyFor(mode CompareMode) string {
+	local := e.lp.semantic(mode != CompareCanonical)
+	if mode != CompareStrict {
+		local = strings.ToLower(local)
+	}
+	return local + "@" + e.canonicalDomain()
+}
+
+// Compare the address with other under the given mode.
+// The result will be 0 if e == other, -1 if e < other, and +1 if e > other.
+func (e *Address) Compare(other *Address, mode CompareMode) int {
+	return strings.Compare(e.KeyFor(mode), other.KeyFor(mode))
+}
+
+// Compare will parse the given email addresses, and then compare them under the given mode.
+// The result will be 0 if first == second, -1 if first < second, and +1 if first > second.
+// An error is returned when either of them is not a legitimate email address
+func Compare(first string, second string, mode CompareMode) (int, error) {
+	eFirst, err := parseEmailAddress(first)
+	if nil != err {
+		return 0, err
+	}
+	eSec, err := parseEmailAddress(second)
+	if nil != err {
+		return 0, err
+	}
+	return eFirst.Compare(eSec, mode), nil
+}
@@ -0,0 +1,194 @@
+package emailaddress
+
+import "testing"
+
+func TestCompare(t *testing.T) {
+	cases := []struct {
+		name        string
+		inputFirst  string
+		inputSecond string
+		mode        CompareMode
+		expected    int
+	}{
+		{
+			name:        "strict same",
+			inputFirst:  `test@test.net`,
+			inputSecond: `test@test.net`,
+			mode:        CompareStrict,
+			expected:    0,
+		},
+		{
+			name:        "strict mixcase local part",
+			inputFirst:  `Test@test.net`,
+			inputSecond: `test@test.net`,
+			mode:        CompareStrict,
+			expected:    -1,
+		},
+		{
+			name:        "strict mixcase domain",
+			inputFirst:  `test@TEST.net`,
+			inputSecond: `test@test.net`,
+			mode:        CompareStrict,
+			expected:    0,
+		},
+		{
+			name:        "strict quoted vs unquoted",
+			inputFirst:  `"john"@example.com`,
+			inputSecond: `john@example.com`,
+			mode:        CompareStrict,
+			expected:    0,
+		},
+		{
+			name:        "strict escaped vs quoted",
+			inputFirst:  `Abc\@def@example.com`,
+			inputSecond: `"Abc@def"@example.com`,
+			mode:        CompareStrict,
+			expected:    0,
+		},
+		{
+			name:        "strict comment",
+			inputFirst:  `(comment)john@example.com`,
+			inputSecond: `john@example.com`,
+			mode:        CompareStrict,
+			expected:    0,
+		},
+		{
+			name:        "strict tags are significant",
+			inputFirst:  `john+a@example.com`,
+			inputSecond: `john+b@example.com`,
+			mode:        CompareStrict,
+			expected:    -1,
+		},
+		{
+			name:        "strict trailing plus is significant",
+			inputFirst:  `a+b+@x.com`,
+			inputSecond: `a+b@x.com`,
+			mode:        CompareStrict,
+			expected:    -1,
+		},
+		{
+			name:        "strict empty tags are significant",
+			inputFirst:  `a++@x.com`,
+			inputSecond: `a+@x.com`,
+			mode:        CompareStrict,
+			expected:    -1,
+		},
+		{
+			name:        "strict trailing dot",
+			inputFirst:  `john@example.com.`,
+			inputSecond: `john@example.com`,
+			mode:        CompareStrict,
+			expected:    0,
+		},
+		{
+			name:        "case-insensitive mixcase",
+			inputFirst:  `JOHN@example.com`,
+			inputSecond: `john@example.com`,
+			mode:        CompareCaseInsensitive,
+			expected:    0,
+		},
+		{
+			name:        "case-insensitive tags are significant",
+			inputFirst:  `john+b@example.com`,
+			inputSecond: `John+a@example.com`,
+			mode:        CompareCaseInsensitive,
+			expected:    1,
+		},
+		{
+			name:        "canonical tags",
+			inputFirst:  `John+b@example.com`,
+			inputSecond: `"john"+a@example.com`,
+			mode:        CompareCanonical,
+			expected:    0,
+		},
+		{
+			name:        "plus in quotation is not a tag",
+			inputFirst:  `"john+b"@example.com`,
+			inputSecond: `john@example.com`,
+			mode:        CompareCanonical,
+			expected:    -1,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			result, err := Compare(c.inputFirst, c.inputSecond, c.mode)
+			if nil != err {
+				st.Errorf("we are not expecting error , however we got:%s", err)
+				st.FailNow()
+			}
+			if result != c.expected {
+				st.Errorf("we expect %d however we got %d", c.expected, result)
+			}
+		})
+	}
+}
+
+func TestCompareInvalid(t *testing.T) {
+	if _, err := Compare("invalid*@test@test.net", "test@test.net", CompareStrict); nil == err {
+		t.Errorf("we are expecting error, however we got nil")
+	}
+	if _, err := Compare("test@test.net", "", CompareStrict); nil == err {
+		t.Errorf("we are expecting error, however we got nil")
+	}
+}
+
+func TestKey(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    string
+		mode     CompareMode
+		expected string
+	}{
+		{
+			name:     "simple",
+			input:    "test@Test.Net",
+			mode:     CompareStrict,
+			expected: "test@test.net",
+		},
+		{
+			name:     "quoted",
+			input:    `"we\"d"@test.net`,
+			mode:     CompareStrict,
+			expected: `we"d@test.net`,
+		},
+		{
+			name:     "comment and tags",
+			input:    `Test+Hello(comment)@test.net`,
+			mode:     CompareStrict,
+			expected: `Test+Hello@test.net`,
+		},
+		{
+			name:     "trailing plus",
+			input:    `a+b+@x.com`,
+			mode:     CompareStrict,
+			expected: `a+b+@x.com`,
+		},
+		{
+			name:     "case-insensitive",
+			input:    `Test+Hello(comment)@test.net`,
+			mode:     CompareCaseInsensitive,
+			expected: `test+hello@test.net`,
+		},
+		{
+			name:     "canonical",
+			input:    `Test+Hello(comment)@test.net`,
+			mode:     CompareCanonical,
+			expected: `test@test.net`,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			e, err := Parse(c.input)
+			if nil != err {
+				st.Errorf("we are not expecting error , however we got:%s", err)
+				st.FailNow()
+			}
+			if key := e.KeyFor(c.mode); key != c.expected {
+				st.Errorf("we are expecting %s, however we got :%s", c.expected, key)
+			}
+			if c.mode == CompareStrict && e.Key() != c.expected {
+				st.Errorf("we are expecting %s, however we got :%s", c.expected, e.Key())
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package emailaddress
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"testing"
+)
+
+// conformanceCase is a line of testdata/conformance.jsonl
+type conformanceCase struct {
+	Address string `json:"address"`
+	// Category is valid, warning (valid with warnings) or invalid
+	Category string `json:"category"`
+	// Code is the expected error code of an invalid address, it is not checked when it is empty
+	Code ErrorCode `json:"code"`
+	// Warnings are the warning codes expected among the warnings of the address
+	Warnings []WarningCode `json:"warnings"`
+	// Source is the test suite the case comes from
+	Source string `json:"source"`
+	Note   string `json:"note"`
+	// Known marks a known disagreement, it is counted in the pass rates but doesn't fail the test
+	Known bool `json:"known"`
+}
+
+func loadConformanceCases(t *testing.T) []conformanceCase {
+	f, err := os.Open("testdata/conformance.jsonl")
+	if nil != err {
+		t.Fatalf("fail to open the conformance corpus: %s", err)
+	}
+	defer f.Close()
+	var cases []conformanceCase
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		var c conformanceCase
+		if err := json.Unmarshal(scanner.Bytes(), &c); nil != err {
+			t.Fatalf("line %d of the conformance corpus is invalid: %s", line, err)
+		}
+		cases = append(cases, c)
+	}
+	if err := scanner.Err(); nil != err {
+		t.Fatalf("fail to read the conformance corpus: %s", err)
+	}
+	return cases
+}
+
+// checkConformance return why the address doesn't conform to the case, or an empty string when it does
+func checkConformance(c conformanceCase) string {
+	e, err := Parse(c.Address)
+	switch c.Category {
+	case "valid", "warning":
+		if nil != err {
+			return fmt.Sprintf("expected %s, however got error %s", c.Category, err)
+		}
+		warnings := e.Warnings()
+		if c.Category == "valid" && len(warnings) > 0 {
+			return fmt.Sprintf("expected no warning, however got %v", warnings)
+		}
+		if c.Category == "warning" && len(warnings) == 0 {
+			return "expected warnings, however got none"
+		}
+		for _, code := range c.Warnings {
+			if !slices.ContainsFunc(warnings, func(w Warning) bool { return w.Code == code }) {
+				return fmt.Sprintf("expected warning %s, however got %v", code, warnings)
+			}
+		}
+	case "invalid":
+		if nil == err {
+			return "expected invalid, however it is accepted"
+		}
+		var pe *ParseError
+		if len(c.Code) > 0 && (!errors.As(err, &pe) || pe.Code != c.Code) {
+			return fmt.Sprintf("expected error code %s, however got %s", c.Code, err)
+		}
+	default:
+		return fmt.Sprintf("unknown category %s", c.Category)
+	}
+	return ""
+}
+
+func TestConformance(t *testing.T) {
+	type rate struct {
+		passed int
+		total  int
+	}
+	rates := make(map[string]*rate)
+	for _, c := range loadConformanceCases(t) {
+		r, ok := rates[c.Category]
+		if !ok {
+			r = &rate{}
+			rates[c.Category] = r
+		}
+		r.total++
+		reason := checkConformance(c)
+		switch {
+		case len(reason) == 0:
+			r.passed++
+			if c.Known {
+				t.Logf("%q (%s) is marked as a known disagreement, however it passes now", c.Address, c.Source)
+			}
+		case !c.Known:
+			t.Errorf("%q (%s %s): %s", c.Address, c.Source, c.Note, reason)
+		}
+	}
+	categories := make([]string, 0, len(rates))
+	for category := range rates {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+	for _, category := range categories {
+		r := rates[category]
+		t.Logf("%-8s %3d/%3d passed (%.1f%%)", category, r.passed, r.total, float64(r.passed)*100/float64(r.total))
+	}
+}
@@ -1,15 +1,163 @@
 package emailaddress
 
 import (
-	"fmt"
+	"context"
 	"net"
+	"net/netip"
+	"strings"
+
+	"golang.org/x/net/idna"
 )
 
+// Domain is a parsed domain name, or an address literal such as [192.0.2.1] or [IPv6:2001:db8::1].
+// The zero value is not a valid domain, use ParseDomain to create one
+type Domain struct {
+	// name is in lowercase without the trailing dot, internationalized domain names are kept in unicode
+	name string
+	// ascii is name in punycode
+	ascii string
+	fqdn  bool
+	ip    netip.Addr
+}
+
+// ParseDomain parse a domain with the same validation as the domain of an email address,
+// which is a domain name or an address literal of RFC 5321.
+// A trailing dot is allowed, it is reported by IsFQDN but it is not part of the canonical form
+func ParseDomain(s string) (Domain, error) {
+	if len(s) == 0 {
+		return Domain{}, newParseError(CodeEmptyDomain, 0, "domain can't be empty")
+	}
+	if len(s) > MaxDomainLength {
+		return Domain{}, newParseError(CodeDomainTooLong, MaxDomainLength, "%s is longer than %d", s, MaxDomainLength)
+	}
+	return parseDomain(s, 0, DefaultLimits)
+}
+
+// parseDomain validate the domain part s of an email address, which is a domain name or an address literal,
+// offset is where the domain start in the input
+func parseDomain(s string, offset int, limits Limits) (Domain, error) {
+	if len(s) > 0 && s[0] == '[' {
+		return parseAddressLiteral(s, offset)
+	}
+	return parseDomainName(s, offset, limits)
+}
+
+// parseDomainName validate the domain name s, offset is where the domain start in the input
+func parseDomainName(s string, offset int, limits Limits) (Domain, error) {
+	ascii, err := toASCII(s)
+	if nil != err {
+		return Domain{}, newParseError(CodeInvalidDomain, offset, "%s is not a valid domain", s)
+	}
+	if err := checkDomainLength(strings.TrimSuffix(ascii, "."), offset, limits); nil != err {
+		return Domain{}, err
+	}
+	if !IsDomainName(ascii) {
+		return Domain{}, newParseError(CodeInvalidDomain, offset, "%s is not a valid domain", s)
+	}
+	d := Domain{
+		ascii: strings.ToLower(strings.TrimSuffix(ascii, ".")),
+		fqdn:  strings.HasSuffix(s, "."),
+	}
+	d.name = d.ascii
+	if !isASCII(s) {
+		// the unicode form of the punycode is mapped already, such as full width characters to ASCII
+		if d.name, err = idna.Lookup.ToUnicode(d.ascii); nil != err {
+			return Domain{}, newParseError(CodeInvalidDomain, offset, "%s is not a valid domain", s)
+		}
+	}
+	return d, nil
+}
+
+// parseAddressLiteral parse [192.0.2.1] and [IPv6:2001:db8::1], offset is where the literal start in the input
+func parseAddressLiteral(s string, offset int) (Domain, error) {
+	if s[len(s)-1] != ']' {
+		return Domain{}, newParseError(CodeInvalidDomain, offset+len(s), "%s is not a valid address literal, it should end with ']'", s)
+	}
+	literal := s[1 : len(s)-1]
+	v6 := len(literal) >= 5 && strings.EqualFold(literal[:5], "IPv6:")
+	if v6 {
+		literal = literal[5:]
+	}
+	ip, err := netip.ParseAddr(literal)
+	if nil != err || ip.Is6() != v6 || ip.Zone() != "" {
+		return Domain{}, newParseError(CodeInvalidDomain, offset+1, "%s is not a valid address literal", s)
+	}
+	d := Domain{ip: ip}
+	if v6 {
+		d.name = "[IPv6:" + ip.String() + "]"
+	} else {
+		d.name = "[" + ip.String() + "]"
+	}
+	d.ascii = d.name
+	return d, nil
+}
+
+// String return the canonical form of the domain, it is in lowercase without the trailing dot
+func (d Domain) String() string {
+	return d.name
+}
+
+// ASCII return the canonical form of the domain in punycode
+func (d Domain) ASCII() string {
+	return d.ascii
+}
+
+// Labels return the labels of the domain from left to right, mail.example.com has labels mail, example and com.
+// An address literal has no labels
+func (d Domain) Labels() []string {
+	if d.IsIP() || d.name == "" {
+		return nil
+	}
+	return strings.Split(d.name, ".")
+}
+
+// TLD return the top level domain, which is the last label, it is empty for an address literal
+func (d Domain) TLD() string {
+	if d.IsIP() {
+		return ""
+	}
+	return d.name[strings.LastIndexByte(d.name, '.')+1:]
+}
+
+// IsFQDN report whether the domain was written fully-qualified, with a trailing dot
+func (d Domain) IsFQDN() bool {
+	return d.fqdn
+}
+
+// IsIP report whether the domain is an address literal
+func (d Domain) IsIP() bool {
+	return d.ip.IsValid()
+}
+
+// IP return the address of an address literal, it is the zero netip.Addr for a domain name
+func (d Domain) IP() netip.Addr {
+	return d.ip
+}
+
+// Equal report whether d and other are the same domain, they are compared in punycode, regardless of the trailing dot
+func (d Domain) Equal(other Domain) bool {
+	return d.ascii == other.ascii
+}
+
+// IsSubdomainOf report whether d is below other, mail.example.com is a subdomain of example.com,
+// a domain is not a subdomain of itself, and address literals have no subdomains
+func (d Domain) IsSubdomainOf(other Domain) bool {
+	if d.IsIP() || other.IsIP() || other.ascii == "" {
+		return false
+	}
+	return strings.HasSuffix(d.ascii, "."+other.ascii)
+}
+
 // IsDomainName checks if a string is a presentation-format domain name
 // (currently restricted to hostname-compatible "preferred name" LDH labels and
 // SRV-like "underscore labels"; see golang.org/issue/12421).
 // https://github.com/golang/go/blob/master/src/net/dnsclient.go#L63
 func IsDomainName(s string) bool {
+	return isDomainName(s)
+}
+
+// isDomainName is the implementation of IsDomainName, so Valid and ValidBytes share it
+func isDomainName[T string | []byte](s T) bool {
 	// See RFC 1035, RFC 3696.
 	// Presentation format has dots before every label except the first, and the
 	// terminal empty label is optional here because we assume fully-qualified
@@ -63,18 +211,74 @@ func IsDomainName(s string) bool {
 	return nonNumeric
 }
 
+// nonASCIIOffset return the offset of the first non-ASCII byte in s, -1 when there is none
+func nonASCIIOffset[T string | []byte](s T) int {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			return i
+		}
+	}
+	return -1
+}
+
+// isASCII checks whether s only contains ASCII characters
+func isASCII(s string) bool {
+	return nonASCIIOffset(s) == -1
+}
+
+// toASCII convert an internationalized domain name to its punycode form,
+// the domain is returned as is when it is ASCII already
+func toASCII(domain string) (string, error) {
+	if isASCII(domain) {
+		return domain, nil
+	}
+	return idna.Lookup.ToASCII(domain)
+}
+
+// toUnicode convert a domain name with punycode labels to Unicode, it is the counterpart of toASCII.
+// The domain is returned as is when it is ASCII without punycode labels
+func toUnicode(domain string) (string, error) {
+	if isASCII(domain) && !hasPunycodeLabel(domain) {
+		return domain, nil
+	}
+	return idna.Lookup.ToUnicode(domain)
+}
+
+// hasPunycodeLabel checks whether a label of domain start with the ACE prefix xn--
+func hasPunycodeLabel(domain string) bool {
+	for len(domain) > 0 {
+		label, rest, _ := strings.Cut(domain, ".")
+		if len(label) >= 4 && strings.EqualFold(label[:4], "xn--") {
+			return true
+		}
+		domain = rest
+	}
+	return false
+}
+
+// isValidDomain checks if a string is a domain name, internationalized domain names are checked in their punycode form
+func isValidDomain(domain string) bool {
+	ascii, err := toASCII(domain)
+	if nil != err {
+		return false
+	}
+	return IsDomainName(ascii)
+}
+
+// lookupMX is replaced in tests, so they don't depend on the DNS
+var lookupMX = net.DefaultResolver.LookupMX
+
 // HasDomainMX will query the DNS on the given domain to find out whether there is a MX for the domain
 // if the given domain has no MX record, the email address that has the domain , is not likely to be legitimate
 func HasDomainMX(domain string) bool {
-	mxes, err := net.LookupMX(domain)
+	return HasDomainMXContext(context.Background(), domain)
+}
+
+// HasDomainMXContext is HasDomainMX with a context, which can cancel the DNS query
+func HasDomainMXContext(ctx context.Context, domain string) bool {
+	mxes, err := lookupMX(ctx, domain)
 	if nil != err {
 		return false
 	}
-	if len(mxes) == 0 {
-		return false
-	}
-	for _, item := range mxes {
-		fmt.Println(item)
-	}
-	return true
+	return len(mxes) > 0
 }
@@ -1,6 +1,11 @@
 package emailaddress
 
-import "testing"
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
 
 func TestIsDomainName(t *testing.T) {
 	cases := []struct {
@@ -68,3 +73,210 @@ func TestIsDomainName(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDomain(t *testing.T) {
+	cases := []struct {
+		name   string
+		input  string
+		domain string
+		ascii  string
+		labels []string
+		tld    string
+		fqdn   bool
+		ip     bool
+		code   ErrorCode
+	}{
+		{
+			name:   "lowercase",
+			input:  "Mail.Example.COM",
+			domain: "mail.example.com",
+			ascii:  "mail.example.com",
+			labels: []string{"mail", "example", "com"},
+			tld:    "com",
+		},
+		{
+			name:   "trailing dot",
+			input:  "example.com.",
+			domain: "example.com",
+			ascii:  "example.com",
+			labels: []string{"example", "com"},
+			tld:    "com",
+			fqdn:   true,
+		},
+		{
+			name:   "single label",
+			input:  "localhost",
+			domain: "localhost",
+			ascii:  "localhost",
+			labels: []string{"localhost"},
+			tld:    "localhost",
+		},
+		{
+			name:   "internationalized",
+			input:  "BÜcher.example",
+			domain: "bücher.example",
+			ascii:  "xn--bcher-kva.example",
+			labels: []string{"bücher", "example"},
+			tld:    "example",
+		},
+		{
+			name:   "ipv4 literal",
+			input:  "[192.0.2.1]",
+			domain: "[192.0.2.1]",
+			ascii:  "[192.0.2.1]",
+			ip:     true,
+		},
+		{
+			name:   "ipv6 literal",
+			input:  "[ipv6:2001:DB8:0::1]",
+			domain: "[IPv6:2001:db8::1]",
+			ascii:  "[IPv6:2001:db8::1]",
+			ip:     true,
+		},
+		{
+			name:  "empty",
+			input: "",
+			code:  CodeEmptyDomain,
+		},
+		{
+			name:  "numeric",
+			input: "192.0.2.1",
+			code:  CodeInvalidDomain,
+		},
+		{
+			name:  "consecutive dots",
+			input: "example..com",
+			code:  CodeInvalidDomain,
+		},
+		{
+			name:  "label too long",
+			input: strings.Repeat("a", 64) + ".com",
+			code:  CodeLabelTooLong,
+		},
+		{
+			name:  "ipv6 without prefix",
+			input: "[2001:db8::1]",
+			code:  CodeInvalidDomain,
+		},
+		{
+			name:  "ipv4 with ipv6 prefix",
+			input: "[IPv6:192.0.2.1]",
+			code:  CodeInvalidDomain,
+		},
+		{
+			name:  "unclosed literal",
+			input: "[192.0.2.1",
+			code:  CodeInvalidDomain,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			d, err := ParseDomain(c.input)
+			if c.code != "" {
+				var pe *ParseError
+				if !errors.As(err, &pe) || pe.Code != c.code {
+					st.Errorf("we are expecting %s, however we got %v", c.code, err)
+				}
+				return
+			}
+			if nil != err {
+				st.Fatalf("we are not expecting error, however we got:%s", err)
+			}
+			if d.String() != c.domain || d.ASCII() != c.ascii {
+				st.Errorf("we are expecting %s (%s), however we got %s (%s)", c.domain, c.ascii, d, d.ASCII())
+			}
+			if !slices.Equal(d.Labels(), c.labels) || d.TLD() != c.tld {
+				st.Errorf("we are expecting labels %v and tld %s, however we got %v and %s", c.labels, c.tld, d.Labels(), d.TLD())
+			}
+			if d.IsFQDN() != c.fqdn || d.IsIP() != c.ip || d.IP().IsValid() != c.ip {
+				st.Errorf("we are expecting fqdn %t and ip %t, however we got %t and %t", c.fqdn, c.ip, d.IsFQDN(), d.IsIP())
+			}
+		})
+	}
+}
+
+func TestDomainIsSubdomainOf(t *testing.T) {
+	cases := []struct {
+		domain   string
+		parent   string
+		expected bool
+		equal    bool
+	}{
+		{domain: "mail.example.com", parent: "example.com", expected: true},
+		{domain: "a.b.example.com.", parent: "Example.COM", expected: true},
+		{domain: "example.com", parent: "example.com", equal: true},
+		{domain: "example.com.", parent: "EXAMPLE.com", equal: true},
+		{domain: "badexample.com", parent: "example.com"},
+		{domain: "example.com", parent: "mail.example.com"},
+		{domain: "mail.bücher.example", parent: "xn--bcher-kva.example", expected: true},
+		{domain: "[192.0.2.1]", parent: "example.com"},
+	}
+	for _, c := range cases {
+		d, err := ParseDomain(c.domain)
+		if nil != err {
+			t.Fatal(err)
+		}
+		parent, err := ParseDomain(c.parent)
+		if nil != err {
+			t.Fatal(err)
+		}
+		if d.IsSubdomainOf(parent) != c.expected {
+			t.Errorf("%s IsSubdomainOf %s, we are expecting %t", c.domain, c.parent, c.expected)
+		}
+		if d.Equal(parent) != c.equal {
+			t.Errorf("%s Equal %s, we are expecting %t", c.domain, c.parent, c.equal)
+		}
+	}
+	if (Domain{}).IsSubdomainOf(Domain{}) {
+		t.Errorf("we are not expecting the zero Domain to be a subdomain")
+	}
+}
+
+func TestAddressParsedDomain(t *testing.T) {
+	cases := []struct {
+		input     string
+		domain    string
+		canonical string
+		fqdn      bool
+		ip        bool
+	}{
+		{input: "johnny@Mail.Example.com.", domain: "mail.example.com", canonical: "johnny@mail.example.com", fqdn: true},
+		{input: "johnny@Bücher.example", domain: "bücher.example", canonical: "johnny@xn--bcher-kva.example"},
+		{input: "postmaster@[192.0.2.1]", domain: "[192.0.2.1]", canonical: "postmaster@[192.0.2.1]", ip: true},
+		{input: "postmaster@[ipv6:2001:DB8::0:1]", domain: "[IPv6:2001:db8::1]", canonical: "postmaster@[IPv6:2001:db8::1]", ip: true},
+	}
+	for _, c := range cases {
+		e, err := Parse(c.input)
+		if nil != err {
+			t.Fatalf("we are not expecting error for %s, however we got:%s", c.input, err)
+		}
+		d := e.ParsedDomain()
+		if d.String() != c.domain || d.IsFQDN() != c.fqdn || d.IsIP() != c.ip {
+			t.Errorf("we are expecting %s, however we got %s", c.domain, d)
+		}
+		if e.Canonical() != c.canonical {
+			t.Errorf("we are expecting %s, however we got %s", c.canonical, e.Canonical())
+		}
+	}
+	if (&Address{}).ParsedDomain().String() != "" {
+		t.Errorf("we are expecting the zero Domain for a zero Address")
+	}
+}
+
+func TestAddressLiteralOffset(t *testing.T) {
+	cases := []struct {
+		input  string
+		offset int
+	}{
+		{input: "a@[192.0.2.1", offset: 12},
+		{input: "a@[192.0.2.256]", offset: 3},
+		{input: "a@[2001:db8::1]", offset: 3},
+	}
+	for _, c := range cases {
+		_, err := Parse(c.input)
+		var pe *ParseError
+		if !errors.As(err, &pe) || pe.Code != CodeInvalidDomain || pe.Offset != c.offset {
+			t.Errorf("we are expecting %s at %d for %s, however we got %v", CodeInvalidDomain, c.offset, c.input, err)
+		}
+	}
+}
@@ -1,7 +1,7 @@
 package emailaddress // import "github.com/johnnyluo/emailaddress"
 
 import (
-	"bytes"
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -9,8 +9,15 @@ import (
 const (
 	// MaxLocalPart is the maximum length of the local part
 	MaxLocalPart = 64
-	// MaxDomainLength the total length of domain should be less than 255 characters
-	MaxDomainLength        = 255
+	// MaxDomainLength the total length of domain should be less than 255 characters, it is the limit of the wire format,
+	// the presentation format without the trailing dot is limited to MaxDomainNameLength
+	MaxDomainLength = 255
+	// MaxDomainNameLength is the maximum length of the domain in punycode without the trailing dot
+	MaxDomainNameLength = 253
+	// MaxLabelLength is the maximum length of a label of the domain
+	MaxLabelLength = 63
+	// MaxAddressLength is the maximum length of the address, as the forward-path of RFC 5321 and the RFC 3696 errata
+	MaxAddressLength       = 254
 	specialLocalCharacters = ` ",:;<>@[\]`
 	validLocalPartChars    = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789!#$%&'*+-/=?^_`{|}~;"
 )
@@ -39,13 +46,16 @@ func (t tag) String() string {
 	return t.emailTags[t.start:t.end]
 }
 
-// email represent an email address
-type email struct {
+// Address represent a parsed email address
+type Address struct {
 	lp     *localPart
 	domain string
+	// dom is the parsed domain
+	dom Domain
+	raw string
 }
 
-func (e email) String() string {
+func (e Address) String() string {
 	return fmt.Sprintf("%s@%s", e.lp, string(e.domain))
 }
 
@@ -65,15 +75,108 @@ func (lp localPart) String() string {
 		b.WriteString("(" + lp.comment + ")")
 	}
 	b.WriteString(lp.localPartEmail)
-	for _, t := range lp.tags {
-		b.WriteString("+" + t.String())
-	}
+	b.WriteString(lp.tagsText())
 	if len(lp.comment) > 0 && !lp.commentAtBegining {
 		b.WriteString("(" + lp.comment + ")")
 	}
 	return b.String()
 }
 
+// tagsText return the tags as they were written with the leading '+', all the tags share the text they are parsed from,
+// which keep a trailing '+' as well
+func (lp localPart) tagsText() string {
+	if len(lp.tags) == 0 {
+		return ""
+	}
+	return lp.tags[0].emailTags
+}
+
+// semantic return the local part without comments, quotation marks and escapes, the tags are kept as they were written
+func (lp localPart) semantic(withTags bool) string {
+	if withTags {
+		return unquote(lp.localPartEmail + lp.tagsText())
+	}
+	return unquote(lp.localPartEmail)
+}
+
+// isQuoted checks whether the local part has quoted strings or escaped characters
+func (lp localPart) isQuoted() bool {
+	if strings.ContainsAny(lp.localPartEmail, `"\`) {
+		return true
+	}
+	for _, t := range lp.tags {
+		if strings.ContainsAny(t.String(), `"\`) {
+			return true
+		}
+	}
+	return false
+}
+
+// unquote removes the quotation marks and backslash escapes from s,
+// thus `"we\"d"` become `we"d`
+func unquote(s string) string {
+	if !strings.ContainsAny(s, `"\`) {
+		return s
+	}
+	b := strings.Builder{}
+	escaped := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case escaped:
+			b.WriteByte(c)
+			escaped = false
+		case c == byteEscape:
+			escaped = true
+		case c == '"':
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// Domain return the domain part of the email address
+func (e *Address) Domain() string {
+	return e.domain
+}
+
+// ParsedDomain return the domain part of the email address as a Domain, it is parsed along with the address,
+// so it is a domain name or an address literal such as [192.0.2.1], and it is the zero Domain for a zero Address
+func (e *Address) ParsedDomain() Domain {
+	return e.dom
+}
+
+// LocalPart return the local part as it was written, but without tags and comments
+func (e *Address) LocalPart() string {
+	return e.lp.localPartEmail
+}
+
+// Tags return the tags in the local part, johnny+news+2019@test.net has tags news and 2019
+func (e *Address) Tags() []string {
+	var tags []string
+	for _, t := range e.lp.tags {
+		tags = append(tags, t.String())
+	}
+	return tags
+}
+
+// Comment return the comment in the local part without the brackets
+func (e *Address) Comment() string {
+	return e.lp.comment
+}
+
+// UnquotedLocalPart return the local part with tags, but without comments, quotation marks and escapes,
+// the unquoted local part of "we\"d"@test.net is we"d
+func (e *Address) UnquotedLocalPart() string {
+	return e.lp.semantic(true)
+}
+
+// Parse the given email address, return an error when it is not a legitimate email address
+func Parse(emailAddress string) (*Address, error) {
+	return parseEmailAddress(emailAddress)
+}
+
 // Validate the given email address
 func Validate(emailAddress string) (bool, error) {
 
@@ -87,16 +190,26 @@ func Validate(emailAddress string) (bool, error) {
 	return true, nil
 }
 
-// parseEmailAddress
-func parseEmailAddress(input string) (*email, error) {
+// parseEmailAddress parse the input under DefaultLimits
+func parseEmailAddress(input string) (*Address, error) {
+	return parseWithLimits(input, DefaultLimits)
+}
+
+// parseWithLimits parse the input, and enforce the length limits
+func parseWithLimits(input string, limits Limits) (*Address, error) {
 	if len(input) == 0 {
-		return nil, ErrEmptyEmail
+		return nil, &ParseError{
+			Code: CodeEmpty,
+			Msg:  ErrEmptyEmail.Error(),
+			Err:  ErrEmptyEmail,
+		}
 	}
 
 	atLoc := -1
 	seeAt := false
 	inQuotation := false
 	var previousChar byte
+	escape := 0
 	for i := 0; i < len(input); i++ {
 		c := input[i]
 		switch c {
@@ -108,44 +221,71 @@ func parseEmailAddress(input string) (*email, error) {
 			if !inQuotation && previousChar != byteEscape {
 				if seeAt {
 					// means there are multiple '@' in the email address
-					return nil, fmt.Errorf("an email address can't have multiple '@' characters")
+					return nil, newParseError(CodeMultipleAt, i, "an email address can't have multiple '@' characters")
 				}
 				seeAt = true
 				atLoc = i
 				continue
 			}
 		}
-		previousChar = c
+		// only an odd run of backslash escapes the next character
+		if c == byteEscape {
+			escape++
+		} else {
+			escape = 0
+		}
+		if escape > 0 && escape%2 == 0 {
+			previousChar = 0
+		} else {
+			previousChar = c
+		}
 	}
 
 	if !seeAt {
-		return nil, fmt.Errorf("%s is not valid email address, the format of email addresses is local-part@domain", input)
+		return nil, newParseError(CodeMissingAt, len(input), "%s is not valid email address, the format of email addresses is local-part@domain", input)
 	}
 
 	lenDomain := len(input) - atLoc - 1
 	if atLoc == 0 {
-		return nil, fmt.Errorf("email address can't start with '@'")
-	}
-	if atLoc > MaxLocalPart {
-		return nil, fmt.Errorf("the length of local part should be less than %d", MaxLocalPart)
+		return nil, newParseError(CodeEmptyLocalPart, 0, "email address can't start with '@'")
 	}
 	if lenDomain > MaxDomainLength {
-		return nil, fmt.Errorf("%s is longer than %d", string(input[atLoc+1:]), MaxDomainLength)
+		return nil, newParseError(CodeDomainTooLong, atLoc+1+MaxDomainLength, "%s is longer than %d", string(input[atLoc+1:]), MaxDomainLength)
 	}
 	if lenDomain == 0 {
-		return nil, fmt.Errorf("domain part can't be empty")
+		return nil, newParseError(CodeEmptyDomain, len(input), "domain part can't be empty")
 	}
 	lpp, err := parseLocalPart(string(input[:atLoc]))
 	if nil != err {
-		return nil, fmt.Errorf("fail to parse localPart of the email address")
+		lpErr := &ParseError{
+			Code: CodeInvalidCharacter,
+			Msg:  "fail to parse localPart of the email address",
+			Err:  err,
+		}
+		var pe *ParseError
+		if errors.As(err, &pe) {
+			lpErr.Code = pe.Code
+			lpErr.Offset = pe.Offset
+		}
+		return nil, lpErr
+	}
+	localLength := len(unquote(lpp.localPartEmail + lpp.tagsText()))
+	if localLength > limits.LocalPart {
+		return nil, newParseError(CodeLocalPartTooLong, localOverflowOffset(input[:atLoc], limits.LocalPart), "the length of local part should be less than %d", limits.LocalPart)
+	}
+	domain, err := parseDomain(input[atLoc+1:], atLoc+1, limits)
+	if nil != err {
+		return nil, err
 	}
-	if !IsDomainName(string(input[atLoc+1:])) {
-		return nil, fmt.Errorf("%s is not a valid domain", string(input[atLoc+1:]))
+	if total := localLength + 1 + len(domain.ASCII()); total > limits.Address {
+		return nil, newParseError(CodeAddressTooLong, addressOverflowOffset(input, atLoc, localLength, domain, limits.Address), "the address is %d octets, it should be no more than %d", total, limits.Address)
 	}
 
-	return &email{
+	return &Address{
 		lp:     lpp,
 		domain: input[atLoc+1:],
+		dom:    domain,
+		raw:    input,
 	}, nil
 }
 
@@ -153,22 +293,23 @@ func parseEmailAddress(input string) (*email, error) {
 func parseLocalPart(lp string) (*localPart, error) {
 	localPartLength := len(lp)
 	if localPartLength == 0 {
-		return nil, fmt.Errorf("empty local part")
+		return nil, newParseError(CodeEmptyLocalPart, 0, "empty local part")
 	}
 	// special case , local part only has one character
 	if localPartLength == 1 {
-		if bytes.Contains([]byte(validLocalPartChars), []byte(lp)) {
+		if charClass[lp[0]]&classLocalSingle != 0 {
 			return &localPart{
 				localPartEmail: lp,
 			}, nil
 		}
-		return nil, fmt.Errorf("%s is invalid in the local part of an email address", lp)
+		return nil, newParseError(CodeInvalidCharacter, 0, "%s is invalid in the local part of an email address", lp)
 	}
 
 	inQuotation := false
 	var previousChar byte
 	escape := 0
-	seeTag := false
+	tagStart := -1
+	quoteStart := -1
 	commentStart := -1
 	commentEnd := -1
 	start := 0
@@ -176,45 +317,63 @@ func parseLocalPart(lp string) (*localPart, error) {
 
 	for idx := 0; idx < len(lp); idx++ {
 		c := lp[idx]
+		inComment := commentStart > -1 && commentEnd == -1
 		switch c {
 		case '"':
 			if previousChar != byteEscape {
 				inQuotation = !inQuotation
+				if inQuotation {
+					quoteStart = idx
+				}
 			}
 		case '+':
-			if previousChar != byteEscape {
-				if !seeTag {
-					end = idx
-				}
-				seeTag = true
+			// a '+' at the start of the local part has nothing to tag, it is part of the local part
+			atStart := idx == 0 || commentStart == 0 && commentEnd == idx-1
+			if !inQuotation && !inComment && previousChar != byteEscape && tagStart == -1 && !atStart {
+				tagStart = idx
 			}
 		case '.':
-			if idx == 0 || idx == (localPartLength-1) {
-				return nil, fmt.Errorf("%c can't be the start or end of local part", c)
+			// a dot next to a comment at the start or the end is at the edge of the local part as well
+			nextToComment := !inQuotation && !inComment && (commentStart == 0 && commentEnd == idx-1 || idx+1 < localPartLength && lp[idx+1] == '(')
+			if idx == 0 || idx == (localPartLength-1) || nextToComment {
+				return nil, newParseError(CodeDotAtEdge, idx, "%c can't be the start or end of local part", c)
 			}
 			if previousChar == '.' && !inQuotation {
-				return nil, fmt.Errorf("consective dot is only valid in quotation")
+				return nil, newParseError(CodeConsecutiveDots, idx, "consective dot is only valid in quotation")
 			}
 		case byteEscape:
 			escape++
 		case ',', ':', ';', '<', '>', '@', '[', ']', ' ':
-			if !inQuotation && previousChar != byteEscape {
-				return nil, fmt.Errorf("%c is only valid in quoted string or escaped", c)
+			if !inQuotation && !inComment && previousChar != byteEscape {
+				return nil, newParseError(CodeInvalidCharacter, idx, "%c is only valid in quoted string or escaped", c)
 			}
 		case '(':
 			if !inQuotation && previousChar != byteEscape {
+				if commentStart > -1 {
+					return nil, newParseError(CodeMisplacedComment, idx, "only one comment is allowed in the local part")
+				}
 				commentStart = idx
 			}
 		case ')':
 			if !inQuotation && previousChar != byteEscape {
+				if !inComment && commentStart > -1 {
+					return nil, newParseError(CodeUnbalancedComment, idx, ") is only valid within quoted string or escaped")
+				}
 				commentEnd = idx
 			}
 		default:
+			if isControl(c) && !(c == '\t' && inQuotation) {
+				return nil, newParseError(CodeInvalidCharacter, idx, "control character %q is not allowed in the local part", c)
+			}
 			if previousChar == byteEscape && !inQuotation {
-				return nil, fmt.Errorf("\\ is only valid in quoted string or escaped")
+				return nil, newParseError(CodeInvalidCharacter, idx-1, "\\ is only valid in quoted string or escaped")
 			}
 		}
 
+		// only an odd run of backslash escapes the next character
+		if c != byteEscape {
+			escape = 0
+		}
 		if escape > 0 && escape%2 == 0 {
 			previousChar = 0
 		} else {
@@ -223,17 +382,23 @@ func parseLocalPart(lp string) (*localPart, error) {
 	}
 
 	if inQuotation {
-		return nil, fmt.Errorf("\" is only valid escaped with baskslash")
+		return nil, newParseError(CodeUnclosedQuote, quoteStart, "\" is only valid escaped with baskslash")
 	}
 	if commentStart > -1 && commentEnd == -1 {
-		return nil, fmt.Errorf("( is only valid within quoted string or escaped")
+		return nil, newParseError(CodeUnbalancedComment, commentStart, "( is only valid within quoted string or escaped")
 	}
 	if commentStart == -1 && commentEnd > -1 {
-		return nil, fmt.Errorf(") is only valid within quoted string or escaped")
+		return nil, newParseError(CodeUnbalancedComment, commentEnd, ") is only valid within quoted string or escaped")
 	}
 
 	if commentStart > commentEnd {
-		return nil, fmt.Errorf("invalid email address")
+		return nil, newParseError(CodeUnbalancedComment, commentEnd, "invalid email address")
+	}
+	if commentStart > 0 && commentEnd != localPartLength-1 {
+		return nil, newParseError(CodeMisplacedComment, commentStart, "comment is only valid at the start or the end of local part")
+	}
+	if commentStart == 0 && commentEnd == localPartLength-1 {
+		return nil, newParseError(CodeEmptyLocalPart, 0, "local part can't be only a comment")
 	}
 	if commentStart == 0 {
 		start = commentEnd + 1
@@ -245,8 +410,12 @@ func parseLocalPart(lp string) (*localPart, error) {
 	lpResult := &localPart{
 		localPartEmail: lp[start:end],
 	}
-	if seeTag {
-		lpResult.tags = getTags(lp[end:])
+	if tagStart > -1 && tagStart < end {
+		// a lone trailing '+' has no tag, it stays in the local part
+		if tags := getTags(lp[tagStart:end]); len(tags) > 0 {
+			lpResult.localPartEmail = lp[start:tagStart]
+			lpResult.tags = tags
+		}
 	}
 
 	if commentStart > -1 && commentEnd > -1 {
@@ -294,21 +463,12 @@ func getTags(t string) []tag {
 }
 
 // Equals will parse the given email addresses , and then compare it.
+// comments and tags are disregarded, and the local part is compared case-insensitively, see CompareCanonical.
 // if first or second are not legitimate email address, this function will return false
 func Equals(first string, second string) bool {
-	eFirst, err := parseEmailAddress(first)
-	if nil != err {
-		return false
-	}
-	eSec, err := parseEmailAddress(second)
+	result, err := Compare(first, second, CompareCanonical)
 	if nil != err {
 		return false
 	}
-	if !strings.EqualFold(eFirst.domain, eSec.domain) {
-		return false
-	}
-	if !strings.EqualFold(eFirst.lp.localPartEmail, eSec.lp.localPartEmail) {
-		return false
-	}
-	return true
+	return result == 0
 }
@@ -7,177 +7,185 @@ import (
 	"testing"
 )
 
+// validateCases are shared by TestValidate and the round-trip tests
+var validateCases = []struct {
+	name           string
+	input          string
+	expectedResult bool
+	err            error
+}{
+	{
+		name:           "empty-email",
+		input:          "",
+		expectedResult: false,
+		err:            ErrEmptyEmail,
+	},
+	{
+		name:           "single-quote email",
+		input:          `"@test.net`,
+		expectedResult: false,
+		err:            fmt.Errorf(`"@test.net is not valid email address, the format of email addresses is local-part@domain`),
+	},
+	{
+		name:           "double-quote email",
+		input:          `"we\"d"@test.net`,
+		expectedResult: true,
+		err:            nil,
+	},
+	{
+		name:           "escaped backslash before quote",
+		input:          `"we\\"@test.net`,
+		expectedResult: true,
+		err:            nil,
+	},
+	{
+		name:           "consective dot email",
+		input:          `we..johnny@test.net`,
+		expectedResult: false,
+		err:            fmt.Errorf("fail to parse localPart of the email address"),
+	},
+	{
+		name:           "consective dot email",
+		input:          `"we..johnny"@test.net`,
+		expectedResult: true,
+		err:            nil,
+	},
+	{
+		name:           "email with comment",
+		input:          `john.smith(comment)@example.com`,
+		expectedResult: true,
+		err:            nil,
+	},
+	{
+		name:           "email with comment1",
+		input:          `(comment)john.smith@example.com`,
+		expectedResult: true,
+		err:            nil,
+	},
+	{
+		name:           "space email",
+		input:          `" "@example.org`,
+		expectedResult: true,
+		err:            nil,
+	},
+	{
+		name:           "email without @",
+		input:          "Abc.example.com",
+		expectedResult: false,
+		err:            fmt.Errorf("Abc.example.com is not valid email address, the format of email addresses is local-part@domain"),
+	},
+	{
+		name:           "multiple @",
+		input:          "A@b@c@example.com",
+		expectedResult: false,
+		err:            fmt.Errorf("an email address can't have multiple '@' characters"),
+	},
+	{
+		name:           "Quote at domain",
+		input:          `test@ex"ample.com`,
+		expectedResult: false,
+		err:            fmt.Errorf(`ex"ample.com is not a valid domain`),
+	},
+	{
+		name:           "email start with @",
+		input:          `@example.com`,
+		expectedResult: false,
+		err:            fmt.Errorf("email address can't start with '@'"),
+	},
+	{
+		name:           "email end with @",
+		input:          `test@`,
+		expectedResult: false,
+		err:            fmt.Errorf("domain part can't be empty"),
+	},
+	{
+		name:           "exceed maximum domain length",
+		input:          `test@abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyz`,
+		expectedResult: false,
+		err:            fmt.Errorf("%s is longer than %d", "abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyz", MaxDomainLength),
+	},
+	{
+		name:           "exceed maximum local part",
+		input:          `abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyz@example.com`,
+		expectedResult: false,
+		err:            fmt.Errorf("the length of local part should be less than %d", MaxLocalPart),
+	},
+	{
+		name:           "email with escape",
+		input:          `te\st@test.net`,
+		expectedResult: false,
+		err:            fmt.Errorf("fail to parse localPart of the email address"),
+	},
+	{
+		name:           "customer/department=shipping@example.com",
+		input:          `customer/department=shipping@example.com`,
+		expectedResult: true,
+		err:            nil,
+	},
+	{
+		name:           "$A12345@example.com",
+		input:          "$A12345@example.com",
+		expectedResult: true,
+		err:            nil,
+	},
+	{
+		name:           "!def!xyz%abc@example.com",
+		input:          "!def!xyz%abc@example.com",
+		expectedResult: true,
+		err:            nil,
+	},
+	{
+		name:           "_somename@example.com",
+		input:          "_somename@example.com",
+		expectedResult: true,
+		err:            nil,
+	},
+	{
+		name:           "multiple dot and tag",
+		input:          "disposable.style.email.with+symbol@example.com",
+		expectedResult: true,
+		err:            nil,
+	},
+	{
+		name:           "simple and legit",
+		input:          "simple@example.com",
+		expectedResult: true,
+		err:            nil,
+	},
+	{
+		name:           "multiple hyphen",
+		input:          "other.email-with-hyphen@example.com",
+		expectedResult: true,
+		err:            nil,
+	},
+	{
+		name:           "hyphens",
+		input:          "fully-qualified-domain@example.com",
+		expectedResult: true,
+		err:            nil,
+	},
+	{
+		name:           "two tags",
+		input:          "user.name+tag+sorting@example.com",
+		expectedResult: true,
+		err:            nil,
+	},
+	{
+		name:           "single letter on local part",
+		input:          "x@example.com",
+		expectedResult: true,
+		err:            nil,
+	},
+	{
+		name:           "hyphen in domain",
+		input:          "example-indeed@strange-example.com",
+		expectedResult: true,
+		err:            nil,
+	},
+}
+
 func TestValidate(t *testing.T) {
-	cases := []struct {
-		name           string
-		input          string
-		expectedResult bool
-		err            error
-	}{
-		{
-			name:           "empty-email",
-			input:          "",
-			expectedResult: false,
-			err:            ErrEmptyEmail,
-		},
-		{
-			name:           "single-quote email",
-			input:          `"@test.net`,
-			expectedResult: false,
-			err:            fmt.Errorf(`"@test.net is not valid email address, the format of email addresses is local-part@domain`),
-		},
-		{
-			name:           "double-quote email",
-			input:          `"we\"d"@test.net`,
-			expectedResult: true,
-			err:            nil,
-		},
-		{
-			name:           "consective dot email",
-			input:          `we..johnny@test.net`,
-			expectedResult: false,
-			err:            fmt.Errorf("fail to parse localPart of the email address"),
-		},
-		{
-			name:           "consective dot email",
-			input:          `"we..johnny"@test.net`,
-			expectedResult: true,
-			err:            nil,
-		},
-		{
-			name:           "email with comment",
-			input:          `john.smith(comment)@example.com`,
-			expectedResult: true,
-			err:            nil,
-		},
-		{
-			name:           "email with comment1",
-			input:          `(comment)john.smith@example.com`,
-			expectedResult: true,
-			err:            nil,
-		},
-		{
-			name:           "space email",
-			input:          `" "@example.org`,
-			expectedResult: true,
-			err:            nil,
-		},
-		{
-			name:           "email without @",
-			input:          "Abc.example.com",
-			expectedResult: false,
-			err:            fmt.Errorf("Abc.example.com is not valid email address, the format of email addresses is local-part@domain"),
-		},
-		{
-			name:           "multiple @",
-			input:          "A@b@c@example.com",
-			expectedResult: false,
-			err:            fmt.Errorf("an email address can't have multiple '@' characters"),
-		},
-		{
-			name:           "Quote at domain",
-			input:          `test@ex"ample.com`,
-			expectedResult: false,
-			err:            fmt.Errorf(`ex"ample.com is not a valid domain`),
-		},
-		{
-			name:           "email start with @",
-			input:          `@example.com`,
-			expectedResult: false,
-			err:            fmt.Errorf("email address can't start with '@'"),
-		},
-		{
-			name:           "email end with @",
-			input:          `test@`,
-			expectedResult: false,
-			err:            fmt.Errorf("domain part can't be empty"),
-		},
-		{
-			name:           "exceed maximum domain length",
-			input:          `test@abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyz`,
-			expectedResult: false,
-			err:            fmt.Errorf("%s is longer than %d", "abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyz", MaxDomainLength),
-		},
-		{
-			name:           "exceed maximum local part",
-			input:          `abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyz@example.com`,
-			expectedResult: false,
-			err:            fmt.Errorf("the length of local part should be less than %d", MaxLocalPart),
-		},
-		{
-			name:           "email with escape",
-			input:          `te\st@test.net`,
-			expectedResult: false,
-			err:            fmt.Errorf("fail to parse localPart of the email address"),
-		},
-		{
-			name:           "customer/department=shipping@example.com",
-			input:          `customer/department=shipping@example.com`,
-			expectedResult: true,
-			err:            nil,
-		},
-		{
-			name:           "$A12345@example.com",
-			input:          "$A12345@example.com",
-			expectedResult: true,
-			err:            nil,
-		},
-		{
-			name:           "!def!xyz%abc@example.com",
-			input:          "!def!xyz%abc@example.com",
-			expectedResult: true,
-			err:            nil,
-		},
-		{
-			name:           "_somename@example.com",
-			input:          "_somename@example.com",
-			expectedResult: true,
-			err:            nil,
-		},
-		{
-			name:           "multiple dot and tag",
-			input:          "disposable.style.email.with+symbol@example.com",
-			expectedResult: true,
-			err:            nil,
-		},
-		{
-			name:           "simple and legit",
-			input:          "simple@example.com",
-			expectedResult: true,
-			err:            nil,
-		},
-		{
-			name:           "multiple hyphen",
-			input:          "other.email-with-hyphen@example.com",
-			expectedResult: true,
-			err:            nil,
-		},
-		{
-			name:           "hyphens",
-			input:          "fully-qualified-domain@example.com",
-			expectedResult: true,
-			err:            nil,
-		},
-		{
-			name:           "two tags",
-			input:          "user.name+tag+sorting@example.com",
-			expectedResult: true,
-			err:            nil,
-		},
-		{
-			name:           "single letter on local part",
-			input:          "x@example.com",
-			expectedResult: true,
-			err:            nil,
-		},
-		{
-			name:           "hyphen in domain",
-			input:          "example-indeed@strange-example.com",
-			expectedResult: true,
-			err:            nil,
-		},
-	}
-	for _, item := range cases {
+	for _, item := range validateCases {
 		t.Run(item.name, func(st *testing.T) {
 			r, err := Validate(item.input)
 			if nil != err && nil == item.err {
@@ -357,6 +365,44 @@ func TestParseLocalPart(t *testing.T) {
 			expectedResult: nil,
 			err:            fmt.Errorf("invalid email address"),
 		},
+		{
+			name:           "comment in the middle",
+			input:          "abc(comment)d",
+			expectedResult: nil,
+			err:            fmt.Errorf("comment is only valid at the start or the end of local part"),
+		},
+		{
+			name:           "two comments",
+			input:          "(a)b(c)",
+			expectedResult: nil,
+			err:            fmt.Errorf("only one comment is allowed in the local part"),
+		},
+		{
+			name:           "nested comments",
+			input:          "((a))b",
+			expectedResult: nil,
+			err:            fmt.Errorf("only one comment is allowed in the local part"),
+		},
+		{
+			name:           "control character",
+			input:          "ab\x01c",
+			expectedResult: nil,
+			err:            fmt.Errorf("control character '\\x01' is not allowed in the local part"),
+		},
+		{
+			name:  "tab in quotation",
+			input: "\"a\tb\"",
+			expectedResult: &localPart{
+				localPartEmail: "\"a\tb\"",
+			},
+			err: nil,
+		},
+		{
+			name:           "only comment",
+			input:          "(comment)",
+			expectedResult: nil,
+			err:            fmt.Errorf("local part can't be only a comment"),
+		},
 	}
 	for _, c := range cases {
 		t.Run(c.name, func(st *testing.T) {
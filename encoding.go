@@ -0,0 +1,82 @@
+package emailaddress
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+)
+
+var (
+	_ encoding.TextMarshaler   = Address{}
+	_ encoding.TextUnmarshaler = &Address{}
+	_ json.Marshaler           = Address{}
+	_ json.Unmarshaler         = &Address{}
+	_ sql.Scanner              = &Address{}
+	_ driver.Valuer            = Address{}
+)
+
+// MarshalText implements encoding.TextMarshaler, the address is marshaled in its canonical form.
+// a zero Address is marshaled as an empty string, which is not decoded back, use *Address for an optional address
+func (e Address) MarshalText() ([]byte, error) {
+	if nil == e.lp {
+		return []byte{}, nil
+	}
+	return []byte(e.Canonical()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, an error is returned when text is not a legitimate email address
+func (e *Address) UnmarshalText(text []byte) error {
+	parsed, err := parseEmailAddress(string(text))
+	if nil != err {
+		return err
+	}
+	*e = *parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, the address is marshaled as a JSON string in its canonical form
+func (e Address) MarshalJSON() ([]byte, error) {
+	text, err := e.MarshalText()
+	if nil != err {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, JSON null is a no-op,
+// an error is returned when data is not a JSON string of a legitimate email address
+func (e *Address) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); nil != err {
+		return fmt.Errorf("email address should be a JSON string: %w", err)
+	}
+	return e.UnmarshalText([]byte(s))
+}
+
+// Scan implements sql.Scanner, NULL result in a zero Address,
+// an error is returned when src is not a legitimate email address
+func (e *Address) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*e = Address{}
+		return nil
+	case string:
+		return e.UnmarshalText([]byte(v))
+	case []byte:
+		return e.UnmarshalText(v)
+	}
+	return fmt.Errorf("can't scan %T into an email address", src)
+}
+
+// Value implements driver.Valuer, the address is stored in its canonical form, and a zero Address is stored as NULL
+func (e Address) Value() (driver.Value, error) {
+	if nil == e.lp {
+		return nil, nil
+	}
+	return e.Canonical(), nil
+}
@@ -0,0 +1,146 @@
+package emailaddress
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestJSON(t *testing.T) {
+	type contact struct {
+		Primary   Address  `json:"primary"`
+		Secondary *Address `json:"secondary"`
+	}
+	cases := []struct {
+		name           string
+		input          string
+		expectedResult string
+		hasErr         bool
+	}{
+		{
+			name:           "both",
+			input:          `{"primary":"(comment)Test@Test.net","secondary":"\"john\"@example.com"}`,
+			expectedResult: `{"primary":"Test@test.net","secondary":"john@example.com"}`,
+		},
+		{
+			name:           "null",
+			input:          `{"primary":"test@test.net","secondary":null}`,
+			expectedResult: `{"primary":"test@test.net","secondary":null}`,
+		},
+		{
+			name:           "missing",
+			input:          `{}`,
+			expectedResult: `{"primary":"","secondary":null}`,
+		},
+		{
+			name:   "invalid address",
+			input:  `{"primary":"test@@test.net"}`,
+			hasErr: true,
+		},
+		{
+			name:   "empty address",
+			input:  `{"primary":""}`,
+			hasErr: true,
+		},
+		{
+			name:   "not a string",
+			input:  `{"primary":1}`,
+			hasErr: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			var ct contact
+			err := json.Unmarshal([]byte(c.input), &ct)
+			if c.hasErr {
+				if nil == err {
+					st.Errorf("we are expecting err, however we got nil")
+				}
+				return
+			}
+			if nil != err {
+				st.Errorf("we are not expecting error , however we got:%s", err)
+				st.FailNow()
+			}
+			buf, err := json.Marshal(ct)
+			if nil != err {
+				st.Errorf("we are not expecting error , however we got:%s", err)
+				st.FailNow()
+			}
+			if string(buf) != c.expectedResult {
+				st.Errorf("we are expecting %s, however we got :%s", c.expectedResult, buf)
+			}
+		})
+	}
+}
+
+func TestUnmarshalTextEmpty(t *testing.T) {
+	var e Address
+	if err := e.UnmarshalText([]byte{}); !errors.Is(err, ErrEmptyEmail) {
+		t.Errorf("we are expecting %s, however we got : %v", ErrEmptyEmail, err)
+	}
+}
+
+func TestSQL(t *testing.T) {
+	cases := []struct {
+		name           string
+		input          interface{}
+		expectedResult interface{}
+		hasErr         bool
+	}{
+		{
+			name:           "string",
+			input:          "Test@Test.net",
+			expectedResult: "Test@test.net",
+		},
+		{
+			name:           "bytes",
+			input:          []byte(`"john"@example.com`),
+			expectedResult: "john@example.com",
+		},
+		{
+			name:           "null",
+			input:          nil,
+			expectedResult: nil,
+		},
+		{
+			name:   "invalid address",
+			input:  "test@",
+			hasErr: true,
+		},
+		{
+			name:   "empty string",
+			input:  "",
+			hasErr: true,
+		},
+		{
+			name:   "unsupported type",
+			input:  1,
+			hasErr: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			var e Address
+			err := e.Scan(c.input)
+			if c.hasErr {
+				if nil == err {
+					st.Errorf("we are expecting err, however we got nil")
+				}
+				return
+			}
+			if nil != err {
+				st.Errorf("we are not expecting error , however we got:%s", err)
+				st.FailNow()
+			}
+			v, err := e.Value()
+			if nil != err {
+				st.Errorf("we are not expecting error , however we got:%s", err)
+				st.FailNow()
+			}
+			if v != c.expectedResult {
+				st.Errorf("we are expecting %v, however we got :%v", c.expectedResult, v)
+			}
+		})
+	}
+}
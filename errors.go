@@ -0,0 +1,80 @@
+package emailaddress
+
+import "fmt"
+
+// ErrorCode classify why an email address is invalid
+type ErrorCode string
+
+const (
+	// CodeEmpty the email address is empty
+	CodeEmpty ErrorCode = "empty"
+	// CodeMissingAt there is no '@' in the email address
+	CodeMissingAt ErrorCode = "missing_at"
+	// CodeMultipleAt there are more than one unquoted '@' in the email address
+	CodeMultipleAt ErrorCode = "multiple_at"
+	// CodeEmptyLocalPart the email address start with '@'
+	CodeEmptyLocalPart ErrorCode = "empty_local_part"
+	// CodeLocalPartTooLong the unquoted local part is longer than MaxLocalPart, or the local part limit of the profile
+	CodeLocalPartTooLong ErrorCode = "local_part_too_long"
+	// CodeEmptyDomain the email address end with '@'
+	CodeEmptyDomain ErrorCode = "empty_domain"
+	// CodeDomainTooLong the domain is longer than MaxDomainLength, or the domain limit of the profile
+	CodeDomainTooLong ErrorCode = "domain_too_long"
+	// CodeLabelTooLong a label of the domain is longer than MaxLabelLength, or the label limit of the profile
+	CodeLabelTooLong ErrorCode = "label_too_long"
+	// CodeAddressTooLong the address is longer than MaxAddressLength, or the address limit of the profile
+	CodeAddressTooLong ErrorCode = "address_too_long"
+	// CodeInvalidDomain the domain is not a valid domain name
+	CodeInvalidDomain ErrorCode = "invalid_domain"
+	// CodeInvalidCharacter a character is only valid in quoted string or escaped
+	CodeInvalidCharacter ErrorCode = "invalid_character"
+	// CodeDotAtEdge the local part start or end with a dot
+	CodeDotAtEdge ErrorCode = "dot_at_edge"
+	// CodeConsecutiveDots the local part has consecutive dots outside quoted string
+	CodeConsecutiveDots ErrorCode = "consecutive_dots"
+	// CodeUnclosedQuote the quoted string is not closed
+	CodeUnclosedQuote ErrorCode = "unclosed_quote"
+	// CodeUnbalancedComment a bracket of the comment has no counterpart
+	CodeUnbalancedComment ErrorCode = "unbalanced_comment"
+	// CodeMisplacedComment the comment is neither at the start nor at the end of the local part, or there are more than one
+	CodeMisplacedComment ErrorCode = "misplaced_comment"
+	// CodeCommentNotAllowed comment is not allowed by the profile
+	CodeCommentNotAllowed ErrorCode = "comment_not_allowed"
+	// CodeQuotedNotAllowed quoted string or escape is not allowed by the profile
+	CodeQuotedNotAllowed ErrorCode = "quoted_not_allowed"
+	// CodeNonASCIINotAllowed non-ASCII character is not allowed by the profile
+	CodeNonASCIINotAllowed ErrorCode = "non_ascii_not_allowed"
+	// CodeAddressLiteralNotAllowed address literal is not allowed by the profile
+	CodeAddressLiteralNotAllowed ErrorCode = "address_literal_not_allowed"
+)
+
+// ParseError is returned when an email address is invalid, it tells what is wrong and where
+type ParseError struct {
+	// Code classify the error
+	Code ErrorCode
+	// Offset is the byte offset in the input where the error is found
+	Offset int
+	// Msg is the human readable description
+	Msg string
+	// Err is the underlying error, it could be nil
+	Err error
+}
+
+// newParseError create a ParseError with the message formatted
+func newParseError(code ErrorCode, offset int, format string, args ...interface{}) *ParseError {
+	return &ParseError{
+		Code:   code,
+		Offset: offset,
+		Msg:    fmt.Sprintf(format, args...),
+	}
+}
+
+// Error implements error
+func (pe *ParseError) Error() string {
+	return pe.Msg
+}
+
+// Unwrap return the underlying error
+func (pe *ParseError) Unwrap() error {
+	return pe.Err
+}
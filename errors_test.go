@@ -0,0 +1,128 @@
+package emailaddress
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseError(t *testing.T) {
+	cases := []struct {
+		name           string
+		input          string
+		profile        Profile
+		expectedCode   ErrorCode
+		expectedOffset int
+	}{
+		{
+			name:           "empty",
+			input:          "",
+			profile:        ProfileRFC5322,
+			expectedCode:   CodeEmpty,
+			expectedOffset: 0,
+		},
+		{
+			name:           "missing at",
+			input:          "Abc.example.com",
+			profile:        ProfileRFC5322,
+			expectedCode:   CodeMissingAt,
+			expectedOffset: 15,
+		},
+		{
+			name:           "multiple at",
+			input:          "A@b@c@example.com",
+			profile:        ProfileRFC5322,
+			expectedCode:   CodeMultipleAt,
+			expectedOffset: 3,
+		},
+		{
+			name:           "start with at",
+			input:          "@example.com",
+			profile:        ProfileRFC5322,
+			expectedCode:   CodeEmptyLocalPart,
+			expectedOffset: 0,
+		},
+		{
+			name:           "empty domain",
+			input:          "test@",
+			profile:        ProfileRFC5322,
+			expectedCode:   CodeEmptyDomain,
+			expectedOffset: 5,
+		},
+		{
+			name:           "invalid domain",
+			input:          `test@ex"ample.com`,
+			profile:        ProfileRFC5322,
+			expectedCode:   CodeInvalidDomain,
+			expectedOffset: 5,
+		},
+		{
+			name:           "consecutive dots",
+			input:          "we..johnny@test.net",
+			profile:        ProfileRFC5322,
+			expectedCode:   CodeConsecutiveDots,
+			expectedOffset: 3,
+		},
+		{
+			name:           "unescaped space",
+			input:          "Fred Bloggs@test.net",
+			profile:        ProfileRFC5322,
+			expectedCode:   CodeInvalidCharacter,
+			expectedOffset: 4,
+		},
+		{
+			name:           "escape outside quotation",
+			input:          `te\st@test.net`,
+			profile:        ProfileRFC5322,
+			expectedCode:   CodeInvalidCharacter,
+			expectedOffset: 2,
+		},
+		{
+			name:           "unbalanced comment",
+			input:          "abc)d@test.net",
+			profile:        ProfileRFC5322,
+			expectedCode:   CodeUnbalancedComment,
+			expectedOffset: 3,
+		},
+		{
+			name:           "comment not allowed",
+			input:          "john(comment)@test.net",
+			profile:        ProfileRFC5321,
+			expectedCode:   CodeCommentNotAllowed,
+			expectedOffset: 4,
+		},
+		{
+			name:           "quoted not allowed",
+			input:          `"john"@test.net`,
+			profile:        ProfileHTML5,
+			expectedCode:   CodeQuotedNotAllowed,
+			expectedOffset: 0,
+		},
+		{
+			name:           "non-ascii not allowed",
+			input:          "john@bücher.example",
+			profile:        ProfileRFC5321,
+			expectedCode:   CodeNonASCIINotAllowed,
+			expectedOffset: 6,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			_, err := ParseWithProfile(c.input, c.profile)
+			var pe *ParseError
+			if !errors.As(err, &pe) {
+				st.Errorf("we are expecting ParseError, however we got :%v", err)
+				st.FailNow()
+			}
+			if pe.Code != c.expectedCode {
+				st.Errorf("we are expecting code %s, however we got :%s", c.expectedCode, pe.Code)
+			}
+			if pe.Offset != c.expectedOffset {
+				st.Errorf("we are expecting offset %d, however we got :%d", c.expectedOffset, pe.Offset)
+			}
+		})
+	}
+	_, err := Parse("")
+	if !errors.Is(err, ErrEmptyEmail) {
+		t.Errorf("we are expecting ErrEmptyEmail, however we got :%v", err)
+	}
+}
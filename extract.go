@@ -0,0 +1,162 @@
+package emailaddress
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Match is an email address found in text
+type Match struct {
+	// Address is the parsed address
+	Address *Address
+	// Start and End are the byte offsets of the address in the text, text[Start:End] is the address
+	Start int
+	End   int
+	// Obfuscated is true when the address is written like john [at] example [dot] com and reconstructed by Extract,
+	// the span covers the obfuscated text then
+	Obfuscated bool
+}
+
+// ExtractOptions configure ExtractWithOptions
+type ExtractOptions struct {
+	// Deobfuscate recognize the addresses written with words or brackets in place of '@' and '.',
+	// such as john [at] example [dot] com or john(at)example(dot)com, in several languages
+	Deobfuscate bool
+	// BareWords accept an obfuscated address of bare words only, such as john at example dot com, when Deobfuscate is set.
+	// Without it a bare '@' word needs another separator in brackets, as such text is more often a sentence,
+	// e.g. look at example dot com, which BareWords take as an address
+	BareWords bool
+}
+
+// enclosingPairs are the punctuations an address is often wrapped in, which are also valid atext
+var enclosingPairs = map[byte]byte{
+	'\'': '\'',
+	'`':  '`',
+	'{':  '}',
+	'|':  '|',
+}
+
+// Extract find all the syntactically valid email addresses in text.
+// Addresses in angle brackets, quotes or followed by punctuation are recognized without the surrounding characters,
+// the local part is either a dot-atom or a quoted string, comments are not recognized in free text,
+// and the domain should have at least two labels
+func Extract(text string) []Match {
+	return ExtractWithOptions(text, ExtractOptions{})
+}
+
+// ExtractWithOptions find all the valid email addresses in text as Extract does, the matches are ordered by their offsets
+func ExtractWithOptions(text string, opts ExtractOptions) []Match {
+	matches := extractPlain(text)
+	if opts.Deobfuscate {
+		matches = mergeMatches(matches, extractObfuscated(text, opts.BareWords))
+	}
+	return matches
+}
+
+// extractPlain find the addresses written as they are
+func extractPlain(text string) []Match {
+	var matches []Match
+	lastEnd := 0
+	for i := 0; i < len(text); i++ {
+		if text[i] != '@' || i < lastEnd {
+			continue
+		}
+		m, ok := matchAt(text, i, lastEnd)
+		if !ok {
+			continue
+		}
+		matches = append(matches, m)
+		lastEnd = m.End
+		i = m.End - 1
+	}
+	return matches
+}
+
+// matchAt try to match an email address around the '@' at position at, the address can't start before min
+func matchAt(text string, at int, min int) (Match, bool) {
+	domainEnd := scanDomain(text, at+1)
+	domain := text[at+1 : domainEnd]
+	if !strings.Contains(strings.TrimSuffix(domain, "."), ".") {
+		return Match{}, false
+	}
+	start, ok := scanLocalPart(text, at, min)
+	if !ok {
+		return Match{}, false
+	}
+	if opener := text[start]; at-start > 1 {
+		if closer, ok := enclosingPairs[opener]; ok && domainEnd < len(text) && text[domainEnd] == closer {
+			start++
+		}
+	}
+	e, err := parseEmailAddress(text[start:domainEnd])
+	if nil != err {
+		return Match{}, false
+	}
+	return Match{
+		Address: e,
+		Start:   start,
+		End:     domainEnd,
+	}, true
+}
+
+// scanDomain return the end of the domain starts at start, the trailing dots and hyphens are not part of it,
+// as they are more likely the punctuation of the sentence
+func scanDomain(text string, start int) int {
+	end := start
+	for end < len(text) {
+		r, size := utf8.DecodeRuneInString(text[end:])
+		if r == '.' || r == '-' || r == '_' || r < utf8.RuneSelf && isLetterDigit(byte(r)) || r >= utf8.RuneSelf && (unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r)) {
+			end += size
+			continue
+		}
+		break
+	}
+	for end > start && (text[end-1] == '.' || text[end-1] == '-') {
+		end--
+	}
+	return end
+}
+
+func isLetterDigit(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9'
+}
+
+// scanLocalPart return the start of the local part ends before the '@' at position at
+func scanLocalPart(text string, at int, min int) (int, bool) {
+	if at == min {
+		return 0, false
+	}
+	if text[at-1] == '"' {
+		// quoted string, look for the unescaped opening quotation mark
+		for q := at - 2; q >= min && at-q <= MaxLocalPart; q-- {
+			if text[q] != '"' {
+				continue
+			}
+			backslashes := 0
+			for b := q - 1; b >= min && text[b] == byteEscape; b-- {
+				backslashes++
+			}
+			if backslashes%2 == 0 {
+				return q, true
+			}
+		}
+		return 0, false
+	}
+	start := at
+	for start > min {
+		r, size := utf8.DecodeLastRuneInString(text[min:start])
+		if r == '.' || r < utf8.RuneSelf && isAtext(byte(r)) || r >= utf8.RuneSelf && (unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r)) {
+			start -= size
+			continue
+		}
+		break
+	}
+	for start < at && text[start] == '.' {
+		start++
+	}
+	if start == at {
+		return 0, false
+	}
+	return start, true
+}
@@ -0,0 +1,109 @@
+package emailaddress
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtract(t *testing.T) {
+	cases := []struct {
+		name           string
+		input          string
+		expectedResult []string
+	}{
+		{
+			name:           "empty",
+			input:          "",
+			expectedResult: nil,
+		},
+		{
+			name:           "no address",
+			input:          "ping @johnny on the chat, or email me at johnny at test dot net",
+			expectedResult: nil,
+		},
+		{
+			name:           "sentence",
+			input:          "Please contact john.smith+support@example.com.",
+			expectedResult: []string{"john.smith+support@example.com"},
+		},
+		{
+			name:           "angle brackets",
+			input:          "From: John Smith <john@example.com>, Jane <jane@example.org>;",
+			expectedResult: []string{"john@example.com", "jane@example.org"},
+		},
+		{
+			name:           "quotes",
+			input:          `send it to 'john@example.com' or "jane@example.org"!`,
+			expectedResult: []string{"john@example.com", "jane@example.org"},
+		},
+		{
+			name:           "braces",
+			input:          "{john@example.com}",
+			expectedResult: []string{"john@example.com"},
+		},
+		{
+			name:           "brackets and punctuation",
+			input:          "(see john@example.com), [jane@example.org]: x@test.net?",
+			expectedResult: []string{"john@example.com", "jane@example.org", "x@test.net"},
+		},
+		{
+			name:           "mailto",
+			input:          `<a href="mailto:john@example.com">mail</a>`,
+			expectedResult: []string{"john@example.com"},
+		},
+		{
+			name:           "quoted local part",
+			input:          `write to "Fred Bloggs"@example.com today`,
+			expectedResult: []string{`"Fred Bloggs"@example.com`},
+		},
+		{
+			name:           "quoted local part with escaped quote",
+			input:          `write to "we\"d"@test.net today`,
+			expectedResult: []string{`"we\"d"@test.net`},
+		},
+		{
+			name:           "leading dots and hyphen",
+			input:          "...john@example.com--",
+			expectedResult: []string{"john@example.com"},
+		},
+		{
+			name:           "unicode",
+			input:          "Kontakt: müller@bücher.de。",
+			expectedResult: []string{"müller@bücher.de"},
+		},
+		{
+			name:           "single label domain",
+			input:          "root@localhost and admin@test.net",
+			expectedResult: []string{"admin@test.net"},
+		},
+		{
+			name:           "multiple at",
+			input:          "a@b@c.com",
+			expectedResult: []string{"b@c.com"},
+		},
+		{
+			name:           "invalid domain",
+			input:          "john@-example.com john@example..com",
+			expectedResult: nil,
+		},
+		{
+			name:           "newlines",
+			input:          "first@test.net\nsecond@test.net\r\n",
+			expectedResult: []string{"first@test.net", "second@test.net"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			var result []string
+			for _, m := range Extract(c.input) {
+				if c.input[m.Start:m.End] != m.Address.Raw() {
+					st.Errorf("we are expecting the span %s to match the address %s", c.input[m.Start:m.End], m.Address.Raw())
+				}
+				result = append(result, m.Address.Raw())
+			}
+			if !reflect.DeepEqual(result, c.expectedResult) {
+				st.Errorf("we are expecting %q , however we got : %q", c.expectedResult, result)
+			}
+		})
+	}
+}
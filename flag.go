@@ -0,0 +1,112 @@
+package emailaddress
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+var (
+	_ flag.Value = &Flag{}
+	_ flag.Value = &ListFlag{}
+)
+
+// Flag hold a single email address, it implements flag.Value and validates the address on Set,
+// so a misconfigured address fails at startup
+//
+//	var notify emailaddress.Flag
+//	flag.Var(&notify, "notify", "email address to notify")
+type Flag struct {
+	address *Address
+}
+
+// Address return the email address, it is nil when the flag is not set
+func (f *Flag) Address() *Address {
+	return f.address
+}
+
+// String implements flag.Value
+func (f *Flag) String() string {
+	if nil == f || nil == f.address {
+		return ""
+	}
+	return f.address.Raw()
+}
+
+// Set implements flag.Value, an error is returned when s is not a legitimate email address
+func (f *Flag) Set(s string) error {
+	e, err := parseEmailAddress(s)
+	if nil != err {
+		return err
+	}
+	f.address = e
+	return nil
+}
+
+// ListFlag hold a list of email addresses, it implements flag.Value.
+// Set accept a comma separated address list, see ParseList, and it can be given multiple times,
+// the addresses are accumulated. The addresses set by SetFromEnv are defaults, the first Set replace them
+type ListFlag struct {
+	addresses []*Address
+	// defaults is true when addresses are set by SetFromEnv
+	defaults bool
+}
+
+// Addresses return the email addresses, it is empty when the flag is not set
+func (l *ListFlag) Addresses() []*Address {
+	return l.addresses
+}
+
+// String implements flag.Value
+func (l *ListFlag) String() string {
+	if nil == l {
+		return ""
+	}
+	raw := make([]string, 0, len(l.addresses))
+	for _, e := range l.addresses {
+		raw = append(raw, e.Raw())
+	}
+	return strings.Join(raw, ",")
+}
+
+// Set implements flag.Value, an error is returned when any of the address in s is not legitimate
+func (l *ListFlag) Set(s string) error {
+	addresses, err := ParseList(s)
+	if nil != err {
+		return err
+	}
+	if l.defaults {
+		l.addresses = nil
+		l.defaults = false
+	}
+	l.addresses = append(l.addresses, addresses...)
+	return nil
+}
+
+// setDefault mark the addresses as defaults, which the next Set replace
+func (l *ListFlag) setDefault() {
+	l.defaults = true
+}
+
+// SetFromEnv set value from the environment variable key, nothing happens when the variable is not present.
+// It let environment variables provide the defaults of flags, call it before flag.Parse,
+// a flag given on the command line replace the value of the environment variable
+//
+//	var notify emailaddress.ListFlag
+//	if err := emailaddress.SetFromEnv(&notify, "NOTIFY_ADDRESSES"); nil != err {
+//		log.Fatal(err)
+//	}
+func SetFromEnv(value flag.Value, key string) error {
+	s, ok := os.LookupEnv(key)
+	if !ok {
+		return nil
+	}
+	if err := value.Set(s); nil != err {
+		return fmt.Errorf("environment variable %s: %w", key, err)
+	}
+	if d, ok := value.(interface{ setDefault() }); ok {
+		d.setDefault()
+	}
+	return nil
+}
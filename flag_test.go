@@ -0,0 +1,101 @@
+package emailaddress
+
+import (
+	"flag"
+	"io"
+	"testing"
+)
+
+func TestFlag(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	var notify Flag
+	var cc ListFlag
+	fs.Var(&notify, "notify", "")
+	fs.Var(&cc, "cc", "")
+	if err := fs.Parse([]string{"-notify", "test@test.net", "-cc", "a@test.net, b@test.net", "-cc", "c@test.net"}); nil != err {
+		t.Errorf("we are not expecting error , however we got:%s", err)
+		t.FailNow()
+	}
+	if notify.Address() == nil || notify.String() != "test@test.net" {
+		t.Errorf("we are expecting test@test.net, however we got :%s", notify.String())
+	}
+	if len(cc.Addresses()) != 3 || cc.String() != "a@test.net,b@test.net,c@test.net" {
+		t.Errorf("we are expecting 3 addresses, however we got :%s", cc.String())
+	}
+
+	if err := fs.Parse([]string{"-notify", "test@"}); nil == err {
+		t.Errorf("we are expecting err, however we got nil")
+	}
+	if err := fs.Parse([]string{"-cc", "a@test.net,test@"}); nil == err {
+		t.Errorf("we are expecting err, however we got nil")
+	}
+}
+
+func TestSetFromEnv(t *testing.T) {
+	var notify Flag
+	if err := SetFromEnv(&notify, "EMAILADDRESS_TEST_NOT_EXIST"); nil != err {
+		t.Errorf("we are not expecting error , however we got:%s", err)
+	}
+	if notify.Address() != nil {
+		t.Errorf("we are expecting nil, however we got :%s", notify.String())
+	}
+	t.Setenv("EMAILADDRESS_TEST_NOTIFY", "test@test.net")
+	if err := SetFromEnv(&notify, "EMAILADDRESS_TEST_NOTIFY"); nil != err {
+		t.Errorf("we are not expecting error , however we got:%s", err)
+	}
+	if notify.String() != "test@test.net" {
+		t.Errorf("we are expecting test@test.net, however we got :%s", notify.String())
+	}
+	t.Setenv("EMAILADDRESS_TEST_NOTIFY", "test@@test.net")
+	err := SetFromEnv(&notify, "EMAILADDRESS_TEST_NOTIFY")
+	if nil == err {
+		t.Errorf("we are expecting err, however we got nil")
+		t.FailNow()
+	}
+	expected := "environment variable EMAILADDRESS_TEST_NOTIFY: an email address can't have multiple '@' characters"
+	if err.Error() != expected {
+		t.Errorf("we are expecting err:%s,however we got :%s", expected, err)
+	}
+}
+
+func TestListFlagFromEnv(t *testing.T) {
+	t.Setenv("EMAILADDRESS_TEST_CC", "a@test.net")
+	cases := []struct {
+		name           string
+		args           []string
+		expectedResult string
+	}{
+		{
+			name:           "default",
+			expectedResult: "a@test.net",
+		},
+		{
+			name:           "replaced by flag",
+			args:           []string{"-cc", "b@test.net"},
+			expectedResult: "b@test.net",
+		},
+		{
+			name:           "flags accumulate",
+			args:           []string{"-cc", "b@test.net", "-cc", "c@test.net"},
+			expectedResult: "b@test.net,c@test.net",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.SetOutput(io.Discard)
+			var cc ListFlag
+			if err := SetFromEnv(&cc, "EMAILADDRESS_TEST_CC"); nil != err {
+				st.Fatalf("we are not expecting error , however we got:%s", err)
+			}
+			fs.Var(&cc, "cc", "")
+			if err := fs.Parse(c.args); nil != err {
+				st.Fatalf("we are not expecting error , however we got:%s", err)
+			}
+			if cc.String() != c.expectedResult {
+				st.Errorf("we are expecting %s, however we got :%s", c.expectedResult, cc.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package emailaddress
+
+import (
+	"net/mail"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func FuzzValidate(f *testing.F) {
+	for _, item := range validateCases {
+		f.Add(item.input)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		ok, err := Validate(input)
+		if ok != (nil == err) {
+			t.Fatalf("%q: Validate return %t with error %v", input, ok, err)
+		}
+		if Valid(input) != ok {
+			t.Fatalf("%q: Validate return %t, however Valid return %t", input, ok, !ok)
+		}
+		if !ok || !utf8.ValidString(input) {
+			return
+		}
+		e, err := Parse(input)
+		if nil != err {
+			t.Fatalf("%q: Validate accepts it, however Parse return %s", input, err)
+		}
+		// Parse -> String -> Parse is stable
+		again, err := Parse(e.String())
+		if nil != err {
+			t.Fatalf("%q: fail to parse %q from String: %s", input, e.String(), err)
+		}
+		if again.String() != e.String() || again.Key() != e.Key() {
+			t.Fatalf("%q: String %q become %q after parsing again", input, e.String(), again.String())
+		}
+		// the canonical form is stable as well
+		canonical, err := Parse(e.Canonical())
+		if nil != err {
+			t.Fatalf("%q: fail to parse canonical %q: %s", input, e.Canonical(), err)
+		}
+		if canonical.Canonical() != e.Canonical() || canonical.Key() != e.Key() {
+			t.Fatalf("%q: canonical %q become %q after parsing again", input, e.Canonical(), canonical.Canonical())
+		}
+		checkNetMail(t, input, e)
+	})
+}
+
+// checkNetMail compare with net/mail on the subset of RFC 5321 both of them implement,
+// a dot-atom local part and an ASCII host name
+func checkNetMail(t *testing.T, input string, e *Address) {
+	at := strings.LastIndexByte(input, '@')
+	local, domain := input[:at], input[at+1:]
+	if !isASCII(input) || !isDotAtom(local) || !strings.Contains(domain, ".") || strings.HasSuffix(domain, ".") {
+		return
+	}
+	addr, err := mail.ParseAddress(input)
+	if nil != err {
+		t.Fatalf("%q: net/mail rejects the address: %s", input, err)
+	}
+	if addr.Address != input {
+		t.Fatalf("%q: net/mail parse it as %q", input, addr.Address)
+	}
+}
+
+func FuzzNetMail(f *testing.F) {
+	for _, item := range validateCases {
+		f.Add(item.input)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		addr, err := mail.ParseAddress(input)
+		if nil != err || addr.Address != input || len(addr.Name) > 0 {
+			return
+		}
+		at := strings.LastIndexByte(input, '@')
+		local, domain := input[:at], input[at+1:]
+		if !isASCII(local) || !isDotAtom(local) || len(local) > MaxLocalPart || len(input) > MaxAddressLength || !IsDomainName(domain) {
+			return
+		}
+		if ok, err := Validate(input); !ok {
+			t.Fatalf("%q: net/mail accepts the address, however Validate return %s", input, err)
+		}
+	})
+}
+
+func FuzzParseLocalPart(f *testing.F) {
+	for _, item := range validateCases {
+		if at := strings.LastIndexByte(item.input, '@'); at > -1 {
+			f.Add(item.input[:at])
+		}
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		lp, err := parseLocalPart(input)
+		length, ok := validLocalPart(input)
+		if ok != (nil == err) {
+			t.Fatalf("%q: parseLocalPart return %v, however validLocalPart return %t", input, err, ok)
+		}
+		if nil != err {
+			if _, ok := err.(*ParseError); !ok {
+				t.Fatalf("%q: we are expecting a *ParseError, however we got %T", input, err)
+			}
+			return
+		}
+		if length != len(unquote(lp.localPartEmail+lp.tagsText())) {
+			t.Fatalf("%q: validLocalPart count %d octets, however the unquoted local part is %q", input, length, unquote(lp.localPartEmail+lp.tagsText()))
+		}
+		if len(lp.localPartEmail) > len(input) || len(lp.comment) > len(input) {
+			t.Fatalf("%q: the parts are longer than the input", input)
+		}
+		for _, tag := range lp.tags {
+			if strings.Contains(tag.String(), "+") && !lp.isQuoted() {
+				t.Fatalf("%q: tag %q contains +", input, tag.String())
+			}
+		}
+	})
+}
+
+func FuzzGetTags(f *testing.F) {
+	for _, seed := range []string{"", "+", "+tag", "+a+b", "a++b", "+a+", "++"} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		tags := getTags(input)
+		if len(input) == 0 || input == "+" {
+			if nil != tags {
+				t.Fatalf("%q: we are expecting no tags, however we got %v", input, tags)
+			}
+			return
+		}
+		var parts []string
+		for _, tag := range tags {
+			parts = append(parts, tag.String())
+		}
+		// the tags are what is between the pluses, the leading plus and a single trailing plus are dropped
+		expected := strings.TrimPrefix(input, "+")
+		expected = strings.TrimSuffix(expected, "+")
+		if joined := strings.Join(parts, "+"); joined != expected && !(len(parts) == 0 && len(expected) == 0) {
+			t.Fatalf("%q: we are expecting tags of %q, however we got %q", input, expected, parts)
+		}
+	})
+}
+
+func FuzzIsDomainName(f *testing.F) {
+	for _, seed := range []string{"test.net", "TEST.net.", "a-b.c_d.e", "-a.b", "a..b", "123.456", strings.Repeat("a", 63) + ".com", strings.Repeat("a", 64) + ".com"} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		if !IsDomainName(input) {
+			return
+		}
+		if len(input) > 254 {
+			t.Fatalf("%q: we are not expecting a domain longer than 254", input)
+		}
+		for _, label := range strings.Split(strings.TrimSuffix(input, "."), ".") {
+			if len(label) == 0 || len(label) > 63 {
+				t.Fatalf("%q: we are not expecting label %q", input, label)
+			}
+		}
+		// domain names are case-insensitive
+		if !IsDomainName(strings.ToUpper(input)) || !IsDomainName(strings.ToLower(input)) {
+			t.Fatalf("%q: we are expecting the domain to be valid in any case", input)
+		}
+		if !isValidDomain(input) {
+			t.Fatalf("%q: IsDomainName accepts it, however isValidDomain rejects it", input)
+		}
+	})
+}
@@ -1,9 +1,18 @@
 module github.com/johnnyluo/emailaddress
 
-go 1.12
+go 1.25.0
 
 require (
 	github.com/fatih/color v1.7.0
+	github.com/mattn/go-isatty v0.0.7
+	golang.org/x/net v0.57.0
+	google.golang.org/grpc v1.82.1
+	google.golang.org/protobuf v1.36.11
+)
+
+require (
 	github.com/mattn/go-colorable v0.1.1 // indirect
-	github.com/mattn/go-isatty v0.0.7 // indirect
+	golang.org/x/sys v0.47.0 // indirect
+	golang.org/x/text v0.40.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20260414002931-afd174a4e478 // indirect
 )
@@ -0,0 +1,693 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: emailaddress.proto
+
+package grpcapi
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ValidateRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Address string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// profile is the name of the validation profile, rfc5322 when it is empty.
+	Profile string `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	// check_mx looks up the MX record of the domain of a valid address.
+	CheckMx       bool `protobuf:"varint,3,opt,name=check_mx,json=checkMx,proto3" json:"check_mx,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateRequest) Reset() {
+	*x = ValidateRequest{}
+	mi := &file_emailaddress_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRequest) ProtoMessage() {}
+
+func (x *ValidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_emailaddress_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateRequest.ProtoReflect.Descriptor instead.
+func (*ValidateRequest) Descriptor() ([]byte, []int) {
+	return file_emailaddress_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ValidateRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ValidateRequest) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *ValidateRequest) GetCheckMx() bool {
+	if x != nil {
+		return x.CheckMx
+	}
+	return false
+}
+
+// Check is the result of an extra check on an address.
+type Check struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Passed        bool                   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Detail        string                 `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Check) Reset() {
+	*x = Check{}
+	mi := &file_emailaddress_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Check) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Check) ProtoMessage() {}
+
+func (x *Check) ProtoReflect() protoreflect.Message {
+	mi := &file_emailaddress_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Check.ProtoReflect.Descriptor instead.
+func (*Check) Descriptor() ([]byte, []int) {
+	return file_emailaddress_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Check) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Check) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *Check) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+// Warning is a diagnosis of a valid but undesirable address, it mirrors emailaddress.Warning.
+type Warning struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Code  string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// offset is the byte offset in the input where the warning is found.
+	Offset        int32  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Warning) Reset() {
+	*x = Warning{}
+	mi := &file_emailaddress_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Warning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Warning) ProtoMessage() {}
+
+func (x *Warning) ProtoReflect() protoreflect.Message {
+	mi := &file_emailaddress_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Warning.ProtoReflect.Descriptor instead.
+func (*Warning) Descriptor() ([]byte, []int) {
+	return file_emailaddress_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Warning) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Warning) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Warning) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Result mirrors emailaddress.Result.
+type Result struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         string                 `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	Valid         bool                   `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	LocalPart     string                 `protobuf:"bytes,3,opt,name=local_part,json=localPart,proto3" json:"local_part,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Comment       string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	Domain        string                 `protobuf:"bytes,6,opt,name=domain,proto3" json:"domain,omitempty"`
+	Canonical     string                 `protobuf:"bytes,7,opt,name=canonical,proto3" json:"canonical,omitempty"`
+	Error         string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode     string                 `protobuf:"bytes,9,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	ErrorOffset   *int32                 `protobuf:"varint,10,opt,name=error_offset,json=errorOffset,proto3,oneof" json:"error_offset,omitempty"`
+	Checks        []*Check               `protobuf:"bytes,11,rep,name=checks,proto3" json:"checks,omitempty"`
+	Warnings      []*Warning             `protobuf:"bytes,12,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Result) Reset() {
+	*x = Result{}
+	mi := &file_emailaddress_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Result) ProtoMessage() {}
+
+func (x *Result) ProtoReflect() protoreflect.Message {
+	mi := &file_emailaddress_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Result.ProtoReflect.Descriptor instead.
+func (*Result) Descriptor() ([]byte, []int) {
+	return file_emailaddress_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Result) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+func (x *Result) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *Result) GetLocalPart() string {
+	if x != nil {
+		return x.LocalPart
+	}
+	return ""
+}
+
+func (x *Result) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Result) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Result) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *Result) GetCanonical() string {
+	if x != nil {
+		return x.Canonical
+	}
+	return ""
+}
+
+func (x *Result) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Result) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *Result) GetErrorOffset() int32 {
+	if x != nil && x.ErrorOffset != nil {
+		return *x.ErrorOffset
+	}
+	return 0
+}
+
+func (x *Result) GetChecks() []*Check {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+func (x *Result) GetWarnings() []*Warning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type NormalizeRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Address string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// profile is the name of the validation profile, rfc5322 when it is empty.
+	Profile string `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	// form is one of canonical, ascii, display, key and raw, canonical when it is empty.
+	Form          string `protobuf:"bytes,3,opt,name=form,proto3" json:"form,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NormalizeRequest) Reset() {
+	*x = NormalizeRequest{}
+	mi := &file_emailaddress_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NormalizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NormalizeRequest) ProtoMessage() {}
+
+func (x *NormalizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_emailaddress_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NormalizeRequest.ProtoReflect.Descriptor instead.
+func (*NormalizeRequest) Descriptor() ([]byte, []int) {
+	return file_emailaddress_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NormalizeRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *NormalizeRequest) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *NormalizeRequest) GetForm() string {
+	if x != nil {
+		return x.Form
+	}
+	return ""
+}
+
+type NormalizeResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Result *Result                `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// normalized is the address in the asked form, it is empty when the address is invalid.
+	Normalized    string `protobuf:"bytes,2,opt,name=normalized,proto3" json:"normalized,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NormalizeResponse) Reset() {
+	*x = NormalizeResponse{}
+	mi := &file_emailaddress_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NormalizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NormalizeResponse) ProtoMessage() {}
+
+func (x *NormalizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_emailaddress_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NormalizeResponse.ProtoReflect.Descriptor instead.
+func (*NormalizeResponse) Descriptor() ([]byte, []int) {
+	return file_emailaddress_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NormalizeResponse) GetResult() *Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *NormalizeResponse) GetNormalized() string {
+	if x != nil {
+		return x.Normalized
+	}
+	return ""
+}
+
+type CheckDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckDomainRequest) Reset() {
+	*x = CheckDomainRequest{}
+	mi := &file_emailaddress_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckDomainRequest) ProtoMessage() {}
+
+func (x *CheckDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_emailaddress_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckDomainRequest.ProtoReflect.Descriptor instead.
+func (*CheckDomainRequest) Descriptor() ([]byte, []int) {
+	return file_emailaddress_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CheckDomainRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+type CheckDomainResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Domain string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	// valid is true when domain is a valid domain name or address literal, as the domain of an address.
+	Valid bool `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	// ascii is the domain in lowercase punycode form, or the canonical form of an address literal.
+	Ascii string `protobuf:"bytes,3,opt,name=ascii,proto3" json:"ascii,omitempty"`
+	// has_mx is true when the domain has MX records, it is false for an address literal.
+	HasMx         bool `protobuf:"varint,4,opt,name=has_mx,json=hasMx,proto3" json:"has_mx,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckDomainResponse) Reset() {
+	*x = CheckDomainResponse{}
+	mi := &file_emailaddress_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckDomainResponse) ProtoMessage() {}
+
+func (x *CheckDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_emailaddress_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckDomainResponse.ProtoReflect.Descriptor instead.
+func (*CheckDomainResponse) Descriptor() ([]byte, []int) {
+	return file_emailaddress_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CheckDomainResponse) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *CheckDomainResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *CheckDomainResponse) GetAscii() string {
+	if x != nil {
+		return x.Ascii
+	}
+	return ""
+}
+
+func (x *CheckDomainResponse) GetHasMx() bool {
+	if x != nil {
+		return x.HasMx
+	}
+	return false
+}
+
+var File_emailaddress_proto protoreflect.FileDescriptor
+
+const file_emailaddress_proto_rawDesc = "" +
+	"\n" +
+	"\x12emailaddress.proto\x12\x0femailaddress.v1\"`\n" +
+	"\x0fValidateRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x18\n" +
+	"\aprofile\x18\x02 \x01(\tR\aprofile\x12\x19\n" +
+	"\bcheck_mx\x18\x03 \x01(\bR\acheckMx\"K\n" +
+	"\x05Check\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06passed\x18\x02 \x01(\bR\x06passed\x12\x16\n" +
+	"\x06detail\x18\x03 \x01(\tR\x06detail\"O\n" +
+	"\aWarning\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x8b\x03\n" +
+	"\x06Result\x12\x14\n" +
+	"\x05input\x18\x01 \x01(\tR\x05input\x12\x14\n" +
+	"\x05valid\x18\x02 \x01(\bR\x05valid\x12\x1d\n" +
+	"\n" +
+	"local_part\x18\x03 \x01(\tR\tlocalPart\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\x12\x16\n" +
+	"\x06domain\x18\x06 \x01(\tR\x06domain\x12\x1c\n" +
+	"\tcanonical\x18\a \x01(\tR\tcanonical\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\t \x01(\tR\terrorCode\x12&\n" +
+	"\ferror_offset\x18\n" +
+	" \x01(\x05H\x00R\verrorOffset\x88\x01\x01\x12.\n" +
+	"\x06checks\x18\v \x03(\v2\x16.emailaddress.v1.CheckR\x06checks\x124\n" +
+	"\bwarnings\x18\f \x03(\v2\x18.emailaddress.v1.WarningR\bwarningsB\x0f\n" +
+	"\r_error_offset\"Z\n" +
+	"\x10NormalizeRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x18\n" +
+	"\aprofile\x18\x02 \x01(\tR\aprofile\x12\x12\n" +
+	"\x04form\x18\x03 \x01(\tR\x04form\"d\n" +
+	"\x11NormalizeResponse\x12/\n" +
+	"\x06result\x18\x01 \x01(\v2\x17.emailaddress.v1.ResultR\x06result\x12\x1e\n" +
+	"\n" +
+	"normalized\x18\x02 \x01(\tR\n" +
+	"normalized\",\n" +
+	"\x12CheckDomainRequest\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\"p\n" +
+	"\x13CheckDomainResponse\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x14\n" +
+	"\x05valid\x18\x02 \x01(\bR\x05valid\x12\x14\n" +
+	"\x05ascii\x18\x03 \x01(\tR\x05ascii\x12\x15\n" +
+	"\x06has_mx\x18\x04 \x01(\bR\x05hasMx2\xdb\x02\n" +
+	"\x13EmailAddressService\x12E\n" +
+	"\bValidate\x12 .emailaddress.v1.ValidateRequest\x1a\x17.emailaddress.v1.Result\x12O\n" +
+	"\x0eValidateStream\x12 .emailaddress.v1.ValidateRequest\x1a\x17.emailaddress.v1.Result(\x010\x01\x12R\n" +
+	"\tNormalize\x12!.emailaddress.v1.NormalizeRequest\x1a\".emailaddress.v1.NormalizeResponse\x12X\n" +
+	"\vCheckDomain\x12#.emailaddress.v1.CheckDomainRequest\x1a$.emailaddress.v1.CheckDomainResponseB+Z)github.com/johnnyluo/emailaddress/grpcapib\x06proto3"
+
+var (
+	file_emailaddress_proto_rawDescOnce sync.Once
+	file_emailaddress_proto_rawDescData []byte
+)
+
+func file_emailaddress_proto_rawDescGZIP() []byte {
+	file_emailaddress_proto_rawDescOnce.Do(func() {
+		file_emailaddress_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_emailaddress_proto_rawDesc), len(file_emailaddress_proto_rawDesc)))
+	})
+	return file_emailaddress_proto_rawDescData
+}
+
+var file_emailaddress_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_emailaddress_proto_goTypes = []any{
+	(*ValidateRequest)(nil),     // 0: emailaddress.v1.ValidateRequest
+	(*Check)(nil),               // 1: emailaddress.v1.Check
+	(*Warning)(nil),             // 2: emailaddress.v1.Warning
+	(*Result)(nil),              // 3: emailaddress.v1.Result
+	(*NormalizeRequest)(nil),    // 4: emailaddress.v1.NormalizeRequest
+	(*NormalizeResponse)(nil),   // 5: emailaddress.v1.NormalizeResponse
+	(*CheckDomainRequest)(nil),  // 6: emailaddress.v1.CheckDomainRequest
+	(*CheckDomainResponse)(nil), // 7: emailaddress.v1.CheckDomainResponse
+}
+var file_emailaddress_proto_depIdxs = []int32{
+	1, // 0: emailaddress.v1.Result.checks:type_name -> emailaddress.v1.Check
+	2, // 1: emailaddress.v1.Result.warnings:type_name -> emailaddress.v1.Warning
+	3, // 2: emailaddress.v1.NormalizeResponse.result:type_name -> emailaddress.v1.Result
+	0, // 3: emailaddress.v1.EmailAddressService.Validate:input_type -> emailaddress.v1.ValidateRequest
+	0, // 4: emailaddress.v1.EmailAddressService.ValidateStream:input_type -> emailaddress.v1.ValidateRequest
+	4, // 5: emailaddress.v1.EmailAddressService.Normalize:input_type -> emailaddress.v1.NormalizeRequest
+	6, // 6: emailaddress.v1.EmailAddressService.CheckDomain:input_type -> emailaddress.v1.CheckDomainRequest
+	3, // 7: emailaddress.v1.EmailAddressService.Validate:output_type -> emailaddress.v1.Result
+	3, // 8: emailaddress.v1.EmailAddressService.ValidateStream:output_type -> emailaddress.v1.Result
+	5, // 9: emailaddress.v1.EmailAddressService.Normalize:output_type -> emailaddress.v1.NormalizeResponse
+	7, // 10: emailaddress.v1.EmailAddressService.CheckDomain:output_type -> emailaddress.v1.CheckDomainResponse
+	7, // [7:11] is the sub-list for method output_type
+	3, // [3:7] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_emailaddress_proto_init() }
+func file_emailaddress_proto_init() {
+	if File_emailaddress_proto != nil {
+		return
+	}
+	file_emailaddress_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_emailaddress_proto_rawDesc), len(file_emailaddress_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_emailaddress_proto_goTypes,
+		DependencyIndexes: file_emailaddress_proto_depIdxs,
+		MessageInfos:      file_emailaddress_proto_msgTypes,
+	}.Build()
+	File_emailaddress_proto = out.File
+	file_emailaddress_proto_goTypes = nil
+	file_emailaddress_proto_depIdxs = nil
+}
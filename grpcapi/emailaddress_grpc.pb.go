@@ -0,0 +1,242 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: emailaddress.proto
+
+package grpcapi
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	EmailAddressService_Validate_FullMethodName       = "/emailaddress.v1.EmailAddressService/Validate"
+	EmailAddressService_ValidateStream_FullMethodName = "/emailaddress.v1.EmailAddressService/ValidateStream"
+	EmailAddressService_Normalize_FullMethodName      = "/emailaddress.v1.EmailAddressService/Normalize"
+	EmailAddressService_CheckDomain_FullMethodName    = "/emailaddress.v1.EmailAddressService/CheckDomain"
+)
+
+// EmailAddressServiceClient is the client API for EmailAddressService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// EmailAddressService validates and normalizes email addresses.
+type EmailAddressServiceClient interface {
+	// Validate a single address.
+	Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*Result, error)
+	// ValidateStream validates the addresses as they arrive, the results are in the order of the requests.
+	ValidateStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ValidateRequest, Result], error)
+	// Normalize an address into the asked form.
+	Normalize(ctx context.Context, in *NormalizeRequest, opts ...grpc.CallOption) (*NormalizeResponse, error)
+	// CheckDomain validates a domain and looks up its MX records.
+	CheckDomain(ctx context.Context, in *CheckDomainRequest, opts ...grpc.CallOption) (*CheckDomainResponse, error)
+}
+
+type emailAddressServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEmailAddressServiceClient(cc grpc.ClientConnInterface) EmailAddressServiceClient {
+	return &emailAddressServiceClient{cc}
+}
+
+func (c *emailAddressServiceClient) Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*Result, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Result)
+	err := c.cc.Invoke(ctx, EmailAddressService_Validate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailAddressServiceClient) ValidateStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ValidateRequest, Result], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &EmailAddressService_ServiceDesc.Streams[0], EmailAddressService_ValidateStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ValidateRequest, Result]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EmailAddressService_ValidateStreamClient = grpc.BidiStreamingClient[ValidateRequest, Result]
+
+func (c *emailAddressServiceClient) Normalize(ctx context.Context, in *NormalizeRequest, opts ...grpc.CallOption) (*NormalizeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NormalizeResponse)
+	err := c.cc.Invoke(ctx, EmailAddressService_Normalize_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailAddressServiceClient) CheckDomain(ctx context.Context, in *CheckDomainRequest, opts ...grpc.CallOption) (*CheckDomainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckDomainResponse)
+	err := c.cc.Invoke(ctx, EmailAddressService_CheckDomain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EmailAddressServiceServer is the server API for EmailAddressService service.
+// All implementations must embed UnimplementedEmailAddressServiceServer
+// for forward compatibility.
+//
+// EmailAddressService validates and normalizes email addresses.
+type EmailAddressServiceServer interface {
+	// Validate a single address.
+	Validate(context.Context, *ValidateRequest) (*Result, error)
+	// ValidateStream validates the addresses as they arrive, the results are in the order of the requests.
+	ValidateStream(grpc.BidiStreamingServer[ValidateRequest, Result]) error
+	// Normalize an address into the asked form.
+	Normalize(context.Context, *NormalizeRequest) (*NormalizeResponse, error)
+	// CheckDomain validates a domain and looks up its MX records.
+	CheckDomain(context.Context, *CheckDomainRequest) (*CheckDomainResponse, error)
+	mustEmbedUnimplementedEmailAddressServiceServer()
+}
+
+// UnimplementedEmailAddressServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEmailAddressServiceServer struct{}
+
+func (UnimplementedEmailAddressServiceServer) Validate(context.Context, *ValidateRequest) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validate not implemented")
+}
+func (UnimplementedEmailAddressServiceServer) ValidateStream(grpc.BidiStreamingServer[ValidateRequest, Result]) error {
+	return status.Errorf(codes.Unimplemented, "method ValidateStream not implemented")
+}
+func (UnimplementedEmailAddressServiceServer) Normalize(context.Context, *NormalizeRequest) (*NormalizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Normalize not implemented")
+}
+func (UnimplementedEmailAddressServiceServer) CheckDomain(context.Context, *CheckDomainRequest) (*CheckDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckDomain not implemented")
+}
+func (UnimplementedEmailAddressServiceServer) mustEmbedUnimplementedEmailAddressServiceServer() {}
+func (UnimplementedEmailAddressServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeEmailAddressServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EmailAddressServiceServer will
+// result in compilation errors.
+type UnsafeEmailAddressServiceServer interface {
+	mustEmbedUnimplementedEmailAddressServiceServer()
+}
+
+func RegisterEmailAddressServiceServer(s grpc.ServiceRegistrar, srv EmailAddressServiceServer) {
+	// If the following call pancis, it indicates UnimplementedEmailAddressServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EmailAddressService_ServiceDesc, srv)
+}
+
+func _EmailAddressService_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailAddressServiceServer).Validate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailAddressService_Validate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailAddressServiceServer).Validate(ctx, req.(*ValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailAddressService_ValidateStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(EmailAddressServiceServer).ValidateStream(&grpc.GenericServerStream[ValidateRequest, Result]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EmailAddressService_ValidateStreamServer = grpc.BidiStreamingServer[ValidateRequest, Result]
+
+func _EmailAddressService_Normalize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NormalizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailAddressServiceServer).Normalize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailAddressService_Normalize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailAddressServiceServer).Normalize(ctx, req.(*NormalizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailAddressService_CheckDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailAddressServiceServer).CheckDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailAddressService_CheckDomain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailAddressServiceServer).CheckDomain(ctx, req.(*CheckDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EmailAddressService_ServiceDesc is the grpc.ServiceDesc for EmailAddressService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EmailAddressService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "emailaddress.v1.EmailAddressService",
+	HandlerType: (*EmailAddressServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Validate",
+			Handler:    _EmailAddressService_Validate_Handler,
+		},
+		{
+			MethodName: "Normalize",
+			Handler:    _EmailAddressService_Normalize_Handler,
+		},
+		{
+			MethodName: "CheckDomain",
+			Handler:    _EmailAddressService_CheckDomain_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ValidateStream",
+			Handler:       _EmailAddressService_ValidateStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "emailaddress.proto",
+}
@@ -0,0 +1,169 @@
+// Package grpcapi expose the validation of email addresses over gRPC, see emailaddress.proto for the service.
+//
+// The generated code is produced with
+//
+//	protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative emailaddress.proto
+package grpcapi
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative emailaddress.proto
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/johnnyluo/emailaddress"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server implements EmailAddressServiceServer with the emailaddress package
+type Server struct {
+	UnimplementedEmailAddressServiceServer
+	// HasMX look up the MX records of a domain, emailaddress.HasDomainMXContext is used when it is nil
+	HasMX func(ctx context.Context, domain string) bool
+}
+
+// NewServer create a Server look up MX records with the DNS
+func NewServer() *Server {
+	return &Server{}
+}
+
+func (s *Server) hasMX(ctx context.Context, domain string) bool {
+	if nil != s.HasMX {
+		return s.HasMX(ctx, domain)
+	}
+	return emailaddress.HasDomainMXContext(ctx, domain)
+}
+
+// lookupProfile return the named profile, rfc5322 when name is empty
+func lookupProfile(name string) (emailaddress.Profile, error) {
+	if len(name) == 0 {
+		return emailaddress.ProfileRFC5322, nil
+	}
+	p, ok := emailaddress.LookupProfile(name)
+	if !ok {
+		return p, status.Errorf(codes.InvalidArgument, "unknown profile %s", name)
+	}
+	return p, nil
+}
+
+// toResult convert the library result to the message
+func toResult(r emailaddress.Result) *Result {
+	result := &Result{
+		Input:     r.Input,
+		Valid:     r.Valid,
+		LocalPart: r.LocalPart,
+		Tags:      r.Tags,
+		Comment:   r.Comment,
+		Domain:    r.Domain,
+		Canonical: r.Canonical,
+		Error:     r.Error,
+		ErrorCode: string(r.ErrorCode),
+	}
+	if nil != r.ErrorOffset {
+		offset := int32(*r.ErrorOffset)
+		result.ErrorOffset = &offset
+	}
+	for _, c := range r.Checks {
+		result.Checks = append(result.Checks, &Check{
+			Name:   c.Name,
+			Passed: c.Passed,
+			Detail: c.Detail,
+		})
+	}
+	for _, w := range r.Warnings {
+		result.Warnings = append(result.Warnings, &Warning{
+			Code:    string(w.Code),
+			Offset:  int32(w.Offset),
+			Message: w.Msg,
+		})
+	}
+	return result
+}
+
+// validate the address in req, and run the checks it asks for
+func (s *Server) validate(ctx context.Context, req *ValidateRequest) (*Result, error) {
+	profile, err := lookupProfile(req.GetProfile())
+	if nil != err {
+		return nil, err
+	}
+	e, err := emailaddress.ParseWithProfile(req.GetAddress(), profile)
+	r := emailaddress.NewResult(req.GetAddress(), e, err)
+	if nil == err && req.GetCheckMx() {
+		// the domain is looked up in its canonical punycode form, the same as httpapi
+		domain := e.ParsedDomain().ASCII()
+		if s.hasMX(ctx, domain) {
+			r.AddCheck("mx", true, domain+" has MX record")
+		} else {
+			r.AddCheck("mx", false, domain+" has no MX record")
+		}
+	}
+	return toResult(r), nil
+}
+
+// Validate implements EmailAddressServiceServer
+func (s *Server) Validate(ctx context.Context, req *ValidateRequest) (*Result, error) {
+	return s.validate(ctx, req)
+}
+
+// ValidateStream implements EmailAddressServiceServer, the results are sent in the order of the requests
+func (s *Server) ValidateStream(stream EmailAddressService_ValidateStreamServer) error {
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if nil != err {
+			return err
+		}
+		result, err := s.validate(stream.Context(), req)
+		if nil != err {
+			return err
+		}
+		if err := stream.Send(result); nil != err {
+			return err
+		}
+	}
+}
+
+// Normalize implements EmailAddressServiceServer
+func (s *Server) Normalize(ctx context.Context, req *NormalizeRequest) (*NormalizeResponse, error) {
+	profile, err := lookupProfile(req.GetProfile())
+	if nil != err {
+		return nil, err
+	}
+	form := emailaddress.FormCanonical
+	if len(req.GetForm()) > 0 {
+		form = emailaddress.Form(req.GetForm())
+	}
+	e, err := emailaddress.ParseWithProfile(req.GetAddress(), profile)
+	resp := &NormalizeResponse{
+		Result: toResult(emailaddress.NewResult(req.GetAddress(), e, err)),
+	}
+	if nil == err {
+		resp.Normalized, err = e.Render(form)
+		if nil != err {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	return resp, nil
+}
+
+// CheckDomain implements EmailAddressServiceServer, the domain is validated as the domain of an address,
+// and the MX records are only looked up for a valid domain name
+func (s *Server) CheckDomain(ctx context.Context, req *CheckDomainRequest) (*CheckDomainResponse, error) {
+	resp := &CheckDomainResponse{
+		Domain: req.GetDomain(),
+	}
+	d, err := emailaddress.ParseDomain(req.GetDomain())
+	if nil != err {
+		return resp, nil
+	}
+	resp.Valid = true
+	resp.Ascii = d.ASCII()
+	if !d.IsIP() {
+		resp.HasMx = s.hasMX(ctx, resp.Ascii)
+	}
+	return resp, nil
+}
@@ -0,0 +1,221 @@
+package grpcapi
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+// newClient start the server on a bufconn listener, and return a client connected to it
+func newClient(t *testing.T) EmailAddressServiceClient {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	RegisterEmailAddressServiceServer(s, &Server{
+		HasMX: func(ctx context.Context, domain string) bool {
+			return domain == "test.net" || domain == "xn--bcher-kva.example"
+		},
+	})
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if nil != err {
+		t.Fatalf("fail to dial bufnet: %s", err)
+	}
+	t.Cleanup(func() {
+		conn.Close()
+		s.Stop()
+	})
+	return NewEmailAddressServiceClient(conn)
+}
+
+func TestValidate(t *testing.T) {
+	client := newClient(t)
+	offset := int32(4)
+	cases := []struct {
+		name           string
+		req            *ValidateRequest
+		expectedResult *Result
+		expectedCode   codes.Code
+	}{
+		{
+			name: "valid",
+			req:  &ValidateRequest{Address: "(comment)John+a@Test.net", CheckMx: true},
+			expectedResult: &Result{
+				Input:     "(comment)John+a@Test.net",
+				Valid:     true,
+				LocalPart: "John",
+				Tags:      []string{"a"},
+				Comment:   "comment",
+				Domain:    "Test.net",
+				Canonical: "John+a@test.net",
+				Checks:    []*Check{{Name: "mx", Passed: true, Detail: "test.net has MX record"}},
+				Warnings:  []*Warning{{Code: "cfws_comment", Offset: 0, Message: "comment is not part of the mailbox"}},
+			},
+		},
+		{
+			name: "unicode domain with trailing dot",
+			req:  &ValidateRequest{Address: "john@Bücher.example.", CheckMx: true},
+			expectedResult: &Result{
+				Input:     "john@Bücher.example.",
+				Valid:     true,
+				LocalPart: "john",
+				Domain:    "Bücher.example.",
+				Canonical: "john@xn--bcher-kva.example",
+				Checks:    []*Check{{Name: "mx", Passed: true, Detail: "xn--bcher-kva.example has MX record"}},
+				Warnings:  []*Warning{{Code: "rfc5322_domain_trailing_dot", Offset: 20, Message: "domain should not end with a dot"}},
+			},
+		},
+		{
+			name: "invalid",
+			req:  &ValidateRequest{Address: "john(comment)@test.net", Profile: "rfc5321"},
+			expectedResult: &Result{
+				Input:       "john(comment)@test.net",
+				Error:       "comment is not allowed by profile rfc5321",
+				ErrorCode:   "comment_not_allowed",
+				ErrorOffset: &offset,
+			},
+		},
+		{
+			name:         "unknown profile",
+			req:          &ValidateRequest{Address: "john@test.net", Profile: "loose"},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			result, err := client.Validate(context.Background(), c.req)
+			if status.Code(err) != c.expectedCode {
+				st.Errorf("we are expecting code %s, however we got :%v", c.expectedCode, err)
+				st.FailNow()
+			}
+			if nil != c.expectedResult && !proto.Equal(result, c.expectedResult) {
+				st.Errorf("we are expecting %v, however we got :%v", c.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestValidateStream(t *testing.T) {
+	client := newClient(t)
+	stream, err := client.ValidateStream(context.Background())
+	if nil != err {
+		t.Fatalf("we are not expecting error , however we got:%s", err)
+	}
+	inputs := []string{"a@test.net", "b@", "c@example.com"}
+	for _, input := range inputs {
+		if err := stream.Send(&ValidateRequest{Address: input}); nil != err {
+			t.Fatalf("we are not expecting error , however we got:%s", err)
+		}
+	}
+	if err := stream.CloseSend(); nil != err {
+		t.Fatalf("we are not expecting error , however we got:%s", err)
+	}
+	var results []*Result
+	for {
+		r, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if nil != err {
+			t.Fatalf("we are not expecting error , however we got:%s", err)
+		}
+		results = append(results, r)
+	}
+	if len(results) != len(inputs) {
+		t.Fatalf("we are expecting %d results, however we got %d", len(inputs), len(results))
+	}
+	for idx, r := range results {
+		if r.GetInput() != inputs[idx] {
+			t.Errorf("we are expecting result of %s, however we got %s", inputs[idx], r.GetInput())
+		}
+		if r.GetValid() != (idx != 1) {
+			t.Errorf("%s: we are not expecting valid to be %t", r.GetInput(), r.GetValid())
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	client := newClient(t)
+	resp, err := client.Normalize(context.Background(), &NormalizeRequest{Address: "john@xn--bcher-kva.example", Form: "display"})
+	if nil != err {
+		t.Fatalf("we are not expecting error , however we got:%s", err)
+	}
+	if resp.GetNormalized() != "john@bücher.example" || !resp.GetResult().GetValid() {
+		t.Errorf("we are expecting john@bücher.example, however we got %v", resp)
+	}
+	resp, err = client.Normalize(context.Background(), &NormalizeRequest{Address: "john"})
+	if nil != err {
+		t.Fatalf("we are not expecting error , however we got:%s", err)
+	}
+	if resp.GetNormalized() != "" || resp.GetResult().GetErrorCode() != "missing_at" {
+		t.Errorf("we are expecting missing_at, however we got %v", resp)
+	}
+	_, err = client.Normalize(context.Background(), &NormalizeRequest{Address: "john@test.net", Form: "upper"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("we are expecting InvalidArgument, however we got %v", err)
+	}
+}
+
+func TestCheckDomain(t *testing.T) {
+	client := newClient(t)
+	cases := []struct {
+		name     string
+		domain   string
+		expected *CheckDomainResponse
+	}{
+		{
+			name:     "with mx",
+			domain:   "Test.net.",
+			expected: &CheckDomainResponse{Domain: "Test.net.", Valid: true, Ascii: "test.net", HasMx: true},
+		},
+		{
+			name:     "unicode with mx",
+			domain:   "bücher.example",
+			expected: &CheckDomainResponse{Domain: "bücher.example", Valid: true, Ascii: "xn--bcher-kva.example", HasMx: true},
+		},
+		{
+			name:     "unicode without mx",
+			domain:   "münchen.example",
+			expected: &CheckDomainResponse{Domain: "münchen.example", Valid: true, Ascii: "xn--mnchen-3ya.example"},
+		},
+		{
+			name:     "address literal",
+			domain:   "[IPv6:2001:DB8::1]",
+			expected: &CheckDomainResponse{Domain: "[IPv6:2001:DB8::1]", Valid: true, Ascii: "[IPv6:2001:db8::1]"},
+		},
+		{
+			name:     "label too long",
+			domain:   "a123456789012345678901234567890123456789012345678901234567890123.net",
+			expected: &CheckDomainResponse{Domain: "a123456789012345678901234567890123456789012345678901234567890123.net"},
+		},
+		{
+			name:     "invalid",
+			domain:   "test..net",
+			expected: &CheckDomainResponse{Domain: "test..net"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			resp, err := client.CheckDomain(context.Background(), &CheckDomainRequest{Domain: c.domain})
+			if nil != err {
+				st.Fatalf("we are not expecting error , however we got:%s", err)
+			}
+			if !proto.Equal(resp, c.expected) {
+				st.Errorf("we are expecting %v, however we got :%v", c.expected, resp)
+			}
+		})
+	}
+}
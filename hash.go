@@ -0,0 +1,78 @@
+package emailaddress
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// HashInput return the normalized address the hashes are computed on, the pipeline is
+//  1. comments are dropped
+//  2. the local part is unquoted and unescaped, "john"@example.com become john@example.com
+//  3. tags are dropped, john+news@example.com become john@example.com
+//  4. the local part is lowercased
+//  5. the domain is converted to lowercase punycode, and the trailing dot is dropped
+//
+// It is the key of CompareCanonical, so two addresses have the same hashes if and only if they are equal under CompareCanonical.
+// Nothing provider specific is applied, the dots of a gmail.com local part are kept
+func (e *Address) HashInput() string {
+	return e.KeyFor(CompareCanonical)
+}
+
+// SHA256 return the hex encoded SHA-256 of HashInput, which is what ad platforms match on
+func (e *Address) SHA256() string {
+	sum := sha256.Sum256([]byte(e.HashInput()))
+	return hex.EncodeToString(sum[:])
+}
+
+// SHA1 return the hex encoded SHA-1 of HashInput
+func (e *Address) SHA1() string {
+	sum := sha1.Sum([]byte(e.HashInput()))
+	return hex.EncodeToString(sum[:])
+}
+
+// MD5 return the hex encoded MD5 of HashInput, the tags are dropped, so it is not the Gravatar key of a tagged address, see GravatarMD5.
+// MD5 is not collision resistant, it should be used only where the other party requires it
+func (e *Address) MD5() string {
+	sum := md5.Sum([]byte(e.HashInput()))
+	return hex.EncodeToString(sum[:])
+}
+
+// GravatarInput return the address as Gravatar and the partners hashing the trimmed and lowercased address hash it.
+// It is HashInput with the tags kept, john+news@Example.com become john+news@example.com
+func (e *Address) GravatarInput() string {
+	return e.KeyFor(CompareCaseInsensitive)
+}
+
+// GravatarSHA256 return the hex encoded SHA-256 of GravatarInput, which is the Gravatar key
+func (e *Address) GravatarSHA256() string {
+	sum := sha256.Sum256([]byte(e.GravatarInput()))
+	return hex.EncodeToString(sum[:])
+}
+
+// GravatarMD5 return the hex encoded MD5 of GravatarInput, which is the legacy Gravatar key
+func (e *Address) GravatarMD5() string {
+	sum := md5.Sum([]byte(e.GravatarInput()))
+	return hex.EncodeToString(sum[:])
+}
+
+// HMAC return the hex encoded HMAC-SHA256 of HashInput with key.
+// Unlike the plain hashes it can't be reversed by hashing a list of known addresses without the key
+func (e *Address) HMAC(key []byte) string {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(e.HashInput()))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// NormalizeForHash trim the white spaces around emailAddress, parse it, and return its HashInput.
+// An error is returned when it is not a legitimate email address
+func NormalizeForHash(emailAddress string) (string, error) {
+	e, err := parseEmailAddress(strings.TrimSpace(emailAddress))
+	if nil != err {
+		return "", err
+	}
+	return e.HashInput(), nil
+}
@@ -0,0 +1,64 @@
+package emailaddress
+
+import "testing"
+
+func TestHash(t *testing.T) {
+	inputs := []string{
+		"john@example.com",
+		"  John@Example.COM\t",
+		`"john"+newsletter(comment)@example.com.`,
+	}
+	for _, input := range inputs {
+		t.Run(input, func(st *testing.T) {
+			normalized, err := NormalizeForHash(input)
+			if nil != err {
+				st.Errorf("we are not expecting error , however we got:%s", err)
+				st.FailNow()
+			}
+			if normalized != "john@example.com" {
+				st.Errorf("we are expecting john@example.com , however we got : %s", normalized)
+			}
+			e, _ := Parse(normalized)
+			if h := e.SHA256(); h != "855f96e983f1f8e8be944692b6f719fd54329826cb62e98015efee8e2e071dd4" {
+				st.Errorf("unexpected SHA-256 %s", h)
+			}
+			if h := e.SHA1(); h != "5224cb6fdd5bbe463af1db8ee499e858fcb79f81" {
+				st.Errorf("unexpected SHA-1 %s", h)
+			}
+			if h := e.MD5(); h != "d4c74594d841139328695756648b6bd6" {
+				st.Errorf("unexpected MD5 %s", h)
+			}
+			if h := e.HMAC([]byte("key")); h != "da94f7c6b701931416ec5044febd44fe36a8b557c0e6485118f88a520c5a7326" {
+				st.Errorf("unexpected HMAC %s", h)
+			}
+		})
+	}
+	if _, err := NormalizeForHash("john@@example.com"); nil == err {
+		t.Errorf("we are expecting error, however we got nil")
+	}
+}
+
+func TestGravatar(t *testing.T) {
+	inputs := []string{
+		"john+news@example.com",
+		`"John"+news(comment)@Example.com.`,
+	}
+	for _, input := range inputs {
+		e, err := Parse(input)
+		if nil != err {
+			t.Fatalf("we are not expecting error , however we got:%s", err)
+		}
+		if normalized := e.GravatarInput(); normalized != "john+news@example.com" {
+			t.Errorf("we are expecting john+news@example.com , however we got : %s", normalized)
+		}
+		if h := e.GravatarMD5(); h != "fea61d122d27a834238cfb0548896284" {
+			t.Errorf("unexpected Gravatar MD5 %s", h)
+		}
+		if h := e.GravatarSHA256(); h != "3460bcb65b86d21c57e0f7941c98e45c9b14b1d7a737cad4208d0cd4952e715a" {
+			t.Errorf("unexpected Gravatar SHA-256 %s", h)
+		}
+		if e.GravatarMD5() == e.MD5() {
+			t.Errorf("we are expecting the tags to be kept in the Gravatar key, however it is the same as MD5")
+		}
+	}
+}
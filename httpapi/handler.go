@@ -0,0 +1,214 @@
+// Package httpapi expose the validation of email addresses over HTTP with JSON bodies.
+//
+//	POST /validate        {"address": "...", "profile": "rfc5321", "check_mx": true}
+//	POST /validate/batch  {"addresses": ["...", "..."], "profile": "rfc5321", "check_mx": true}
+//	GET  /normalize?address=...&form=canonical
+//	GET  /healthz
+//
+// The results are the emailaddress.Result the library produces.
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/johnnyluo/emailaddress"
+)
+
+// Config is the limits of the handler
+type Config struct {
+	// MaxBodyBytes is the maximum size of a request body, 1MB when it is not positive
+	MaxBodyBytes int64
+	// MaxBatchSize is the maximum number of addresses in a batch request, 1000 when it is not positive
+	MaxBatchSize int
+	// Concurrency is the number of workers validating a batch, runtime.NumCPU() when it is not positive
+	Concurrency int
+	// DomainInterval is the minimum interval between two MX lookups of the same domain in a batch
+	DomainInterval time.Duration
+}
+
+// withDefaults fill in the defaults of the zero fields
+func (cfg Config) withDefaults() Config {
+	if cfg.MaxBodyBytes <= 0 {
+		cfg.MaxBodyBytes = 1 << 20
+	}
+	if cfg.MaxBatchSize <= 0 {
+		cfg.MaxBatchSize = 1000
+	}
+	return cfg
+}
+
+// ValidateRequest is the body of POST /validate
+type ValidateRequest struct {
+	Address string `json:"address"`
+	// Profile is the name of the profile, rfc5322 when it is empty
+	Profile string `json:"profile,omitempty"`
+	CheckMX bool   `json:"check_mx,omitempty"`
+}
+
+// BatchRequest is the body of POST /validate/batch
+type BatchRequest struct {
+	Addresses []string `json:"addresses"`
+	// Profile is the name of the profile, rfc5322 when it is empty
+	Profile string `json:"profile,omitempty"`
+	CheckMX bool   `json:"check_mx,omitempty"`
+}
+
+// BatchResponse is the body responded to POST /validate/batch, Results[i] is the result of Addresses[i]
+type BatchResponse struct {
+	Results []emailaddress.Result `json:"results"`
+}
+
+// NormalizeResponse is the body responded to GET /normalize
+type NormalizeResponse struct {
+	emailaddress.Result
+	// Normalized is the address in the asked form, it is empty when the address is invalid
+	Normalized string `json:"normalized,omitempty"`
+}
+
+// ErrorResponse is the body responded when the request can't be served
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+type handler struct {
+	cfg Config
+}
+
+// NewHandler create the http.Handler serving the endpoints
+func NewHandler(cfg Config) http.Handler {
+	h := &handler{
+		cfg: cfg.withDefaults(),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /validate", h.validate)
+	mux.HandleFunc("POST /validate/batch", h.validateBatch)
+	mux.HandleFunc("GET /normalize", h.normalize)
+	mux.HandleFunc("GET /healthz", h.health)
+	return mux
+}
+
+// lookupProfile return the named profile, rfc5322 when name is empty
+func lookupProfile(name string) (emailaddress.Profile, error) {
+	if len(name) == 0 {
+		return emailaddress.ProfileRFC5322, nil
+	}
+	p, ok := emailaddress.LookupProfile(name)
+	if !ok {
+		return p, fmt.Errorf("unknown profile %s", name)
+	}
+	return p, nil
+}
+
+// decode the JSON body into v, and write the error response when it fails
+func (h *handler) decode(w http.ResponseWriter, req *http.Request, v interface{}) bool {
+	req.Body = http.MaxBytesReader(w, req.Body, h.cfg.MaxBodyBytes)
+	dec := json.NewDecoder(req.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); nil != err {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, fmt.Errorf("request body is larger than %d bytes", tooLarge.Limit))
+			return false
+		}
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return false
+	}
+	return true
+}
+
+func (h *handler) validate(w http.ResponseWriter, req *http.Request) {
+	var body ValidateRequest
+	if !h.decode(w, req, &body) {
+		return
+	}
+	profile, err := lookupProfile(body.Profile)
+	if nil != err {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	results, err := emailaddress.ValidateSlice(req.Context(), []string{body.Address}, emailaddress.BatchOptions{
+		Concurrency: 1,
+		Profile:     profile,
+		CheckMX:     body.CheckMX,
+	})
+	if nil != err {
+		writeError(w, http.StatusServiceUnavailable, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, results[0])
+}
+
+func (h *handler) validateBatch(w http.ResponseWriter, req *http.Request) {
+	var body BatchRequest
+	if !h.decode(w, req, &body) {
+		return
+	}
+	if len(body.Addresses) > h.cfg.MaxBatchSize {
+		writeError(w, http.StatusRequestEntityTooLarge, fmt.Errorf("a batch can't have more than %d addresses", h.cfg.MaxBatchSize))
+		return
+	}
+	profile, err := lookupProfile(body.Profile)
+	if nil != err {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	results, err := emailaddress.ValidateSlice(req.Context(), body.Addresses, emailaddress.BatchOptions{
+		Concurrency:    h.cfg.Concurrency,
+		Profile:        profile,
+		CheckMX:        body.CheckMX,
+		DomainInterval: h.cfg.DomainInterval,
+	})
+	if nil != err {
+		writeError(w, http.StatusServiceUnavailable, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, BatchResponse{
+		Results: results,
+	})
+}
+
+func (h *handler) normalize(w http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+	form := emailaddress.FormCanonical
+	if f := query.Get("form"); len(f) > 0 {
+		form = emailaddress.Form(f)
+	}
+	profile, err := lookupProfile(query.Get("profile"))
+	if nil != err {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	input := query.Get("address")
+	e, err := emailaddress.ParseWithProfile(input, profile)
+	resp := NormalizeResponse{
+		Result: emailaddress.NewResult(input, e, err),
+	}
+	if nil == err {
+		resp.Normalized, err = e.Render(form)
+		if nil != err {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *handler) health(w http.ResponseWriter, req *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, ErrorResponse{
+		Error: err.Error(),
+	})
+}
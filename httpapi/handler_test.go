@@ -0,0 +1,145 @@
+package httpapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler(t *testing.T) {
+	h := NewHandler(Config{
+		MaxBodyBytes: 256,
+		MaxBatchSize: 2,
+	})
+	cases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "validate",
+			method:         http.MethodPost,
+			target:         "/validate",
+			body:           `{"address":"(comment)John+a@Test.net"}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"input":"(comment)John+a@Test.net","valid":true,"local_part":"John","tags":["a"],"comment":"comment","domain":"Test.net","canonical":"John+a@test.net","warnings":[{"code":"cfws_comment","offset":0,"message":"comment is not part of the mailbox"}]}`,
+		},
+		{
+			name:           "validate invalid",
+			method:         http.MethodPost,
+			target:         "/validate",
+			body:           `{"address":"john(comment)@test.net","profile":"rfc5321"}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"input":"john(comment)@test.net","valid":false,"error":"comment is not allowed by profile rfc5321","error_code":"comment_not_allowed","error_offset":4}`,
+		},
+		{
+			name:           "validate unknown profile",
+			method:         http.MethodPost,
+			target:         "/validate",
+			body:           `{"address":"john@test.net","profile":"loose"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":"unknown profile loose"}`,
+		},
+		{
+			name:           "validate unknown field",
+			method:         http.MethodPost,
+			target:         "/validate",
+			body:           `{"email":"john@test.net"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":"invalid request body: json: unknown field \"email\""}`,
+		},
+		{
+			name:           "validate body too large",
+			method:         http.MethodPost,
+			target:         "/validate",
+			body:           `{"address":"` + strings.Repeat("a", 300) + `@test.net"}`,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+			expectedBody:   `{"error":"request body is larger than 256 bytes"}`,
+		},
+		{
+			name:           "validate wrong method",
+			method:         http.MethodGet,
+			target:         "/validate",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:           "batch",
+			method:         http.MethodPost,
+			target:         "/validate/batch",
+			body:           `{"addresses":["a@test.net","b@"]}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"results":[{"input":"a@test.net","valid":true,"local_part":"a","domain":"test.net","canonical":"a@test.net"},{"input":"b@","valid":false,"error":"domain part can't be empty","error_code":"empty_domain","error_offset":2}]}`,
+		},
+		{
+			name:           "batch empty",
+			method:         http.MethodPost,
+			target:         "/validate/batch",
+			body:           `{"addresses":[]}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"results":[]}`,
+		},
+		{
+			name:           "batch too large",
+			method:         http.MethodPost,
+			target:         "/validate/batch",
+			body:           `{"addresses":["a@test.net","b@test.net","c@test.net"]}`,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+			expectedBody:   `{"error":"a batch can't have more than 2 addresses"}`,
+		},
+		{
+			name:           "normalize",
+			method:         http.MethodGet,
+			target:         "/normalize?address=%22john%22%40Test.net",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"input":"\"john\"@Test.net","valid":true,"local_part":"\"john\"","domain":"Test.net","canonical":"john@test.net","warnings":[{"code":"rfc5321_quoted_string","offset":0,"message":"quoted string is not supported by many systems"}],"normalized":"john@test.net"}`,
+		},
+		{
+			name:           "normalize display",
+			method:         http.MethodGet,
+			target:         "/normalize?address=john%40xn--bcher-kva.example&form=display",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"input":"john@xn--bcher-kva.example","valid":true,"local_part":"john","domain":"xn--bcher-kva.example","canonical":"john@xn--bcher-kva.example","normalized":"john@bücher.example"}`,
+		},
+		{
+			name:           "normalize invalid",
+			method:         http.MethodGet,
+			target:         "/normalize?address=john",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"input":"john","valid":false,"error":"john is not valid email address, the format of email addresses is local-part@domain","error_code":"missing_at","error_offset":4}`,
+		},
+		{
+			name:           "normalize unknown form",
+			method:         http.MethodGet,
+			target:         "/normalize?address=john%40test.net&form=upper",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":"unknown form upper"}`,
+		},
+		{
+			name:           "health",
+			method:         http.MethodGet,
+			target:         "/healthz",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"status":"ok"}`,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			req := httptest.NewRequest(c.method, c.target, strings.NewReader(c.body))
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			if rec.Code != c.expectedStatus {
+				st.Errorf("we are expecting status %d, however we got %d", c.expectedStatus, rec.Code)
+			}
+			if len(c.expectedBody) == 0 {
+				return
+			}
+			if body := strings.TrimSpace(rec.Body.String()); body != c.expectedBody {
+				st.Errorf("we are expecting body %s, however we got %s", c.expectedBody, body)
+			}
+		})
+	}
+}
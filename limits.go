@@ -0,0 +1,108 @@
+package emailaddress
+
+import "strings"
+
+// Limits are the length limits of an email address in octets.
+// The local part is counted in its unquoted form with tags but without comments,
+// thus "john"@example.com is as long as john@example.com, and the domain is counted in punycode without the trailing dot.
+// A zero field takes the default, see DefaultLimits
+type Limits struct {
+	// LocalPart limit the local part
+	LocalPart int
+	// Domain limit the domain, it can't be raised above MaxDomainNameLength, which is the limit of DNS
+	Domain int
+	// Label limit every label of the domain, it can't be raised above MaxLabelLength, which is the limit of DNS
+	Label int
+	// Address limit the local part, '@' and the domain together
+	Address int
+}
+
+// DefaultLimits are the limits of RFC 5321 and the RFC 3696 errata, Parse enforces them
+var DefaultLimits = Limits{
+	LocalPart: MaxLocalPart,
+	Domain:    MaxDomainNameLength,
+	Label:     MaxLabelLength,
+	Address:   MaxAddressLength,
+}
+
+// withDefaults fill in the defaults of the zero fields, and of the domain limits raised above DNS
+func (l Limits) withDefaults() Limits {
+	if l.LocalPart <= 0 {
+		l.LocalPart = DefaultLimits.LocalPart
+	}
+	if l.Domain <= 0 || l.Domain > MaxDomainNameLength {
+		l.Domain = DefaultLimits.Domain
+	}
+	if l.Label <= 0 || l.Label > MaxLabelLength {
+		l.Label = DefaultLimits.Label
+	}
+	if l.Address <= 0 {
+		l.Address = DefaultLimits.Address
+	}
+	return l
+}
+
+// checkDomainLength checks the domain in punycode without the trailing dot, offset is where the domain start in the input
+func checkDomainLength(domain string, offset int, limits Limits) error {
+	if len(domain) > limits.Domain {
+		return newParseError(CodeDomainTooLong, offset, "%s is longer than %d", domain, limits.Domain)
+	}
+	for len(domain) > 0 {
+		label, rest, _ := strings.Cut(domain, ".")
+		if len(label) > limits.Label {
+			return newParseError(CodeLabelTooLong, offset, "label %s of the domain is longer than %d", label, limits.Label)
+		}
+		offset += len(label) + 1
+		domain = rest
+	}
+	return nil
+}
+
+// localOverflowOffset return the offset in the local part of the octet which takes its unquoted form over n octets,
+// the quotes, the escapes and the comment are not counted as parseLocalPart drop them
+func localOverflowOffset(local string, n int) int {
+	count := 0
+	inQuotation, inComment, escaped := false, false, false
+	for i := 0; i < len(local); i++ {
+		c := local[i]
+		switch {
+		case inComment:
+			if c == byteEscape {
+				i++
+				continue
+			}
+			inComment = c != ')'
+			continue
+		case escaped:
+			escaped = false
+		case c == byteEscape:
+			escaped = true
+			continue
+		case c == '"':
+			inQuotation = !inQuotation
+			continue
+		case c == '(' && !inQuotation:
+			inComment = true
+			continue
+		}
+		if count++; count > n {
+			return i
+		}
+	}
+	return len(local)
+}
+
+// addressOverflowOffset return the offset in the input of the octet which takes the address over limit octets.
+// The domain is counted in punycode, when its input isn't the same text the offset is where the domain start
+func addressOverflowOffset(input string, atLoc int, localLength int, domain Domain, limit int) int {
+	if localLength > limit {
+		return localOverflowOffset(input[:atLoc], limit)
+	}
+	if localLength == limit {
+		return atLoc
+	}
+	if !strings.EqualFold(strings.TrimSuffix(input[atLoc+1:], "."), domain.ASCII()) {
+		return atLoc + 1
+	}
+	return atLoc + 1 + limit - localLength - 1
+}
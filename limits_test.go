@@ -0,0 +1,166 @@
+package emailaddress
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestLimits(t *testing.T) {
+	label := strings.Repeat("b", MaxLabelLength)
+	longDomain := strings.Repeat(label+".", 3) + strings.Repeat("c", 60)
+	cases := []struct {
+		name    string
+		input   string
+		profile Profile
+		code    ErrorCode
+		offset  int
+	}{
+		{
+			name:  "local part of 64 octets",
+			input: strings.Repeat("a", 64) + "@test.net",
+		},
+		{
+			name:   "local part of 65 octets",
+			input:  strings.Repeat("a", 65) + "@test.net",
+			code:   CodeLocalPartTooLong,
+			offset: 64,
+		},
+		{
+			name:   "quoted local part of 65 octets",
+			input:  `"` + strings.Repeat("a", 65) + `"@test.net`,
+			code:   CodeLocalPartTooLong,
+			offset: 65,
+		},
+		{
+			name:   "escaped quotes of 65 octets",
+			input:  `"` + strings.Repeat(`\"`, 65) + `"@test.net`,
+			code:   CodeLocalPartTooLong,
+			offset: 130,
+		},
+		{
+			name:   "comment before local part of 65 octets",
+			input:  "(" + strings.Repeat("c", 20) + ")" + strings.Repeat("a", 65) + "@test.net",
+			code:   CodeLocalPartTooLong,
+			offset: 86,
+		},
+		{
+			name:  "quoted local part counted unquoted",
+			input: `"` + strings.Repeat("a", 64) + `"@test.net`,
+		},
+		{
+			name:  "comment is not counted",
+			input: "(" + strings.Repeat("c", 20) + ")" + strings.Repeat("a", 60) + "@test.net",
+		},
+		{
+			name:   "label of 64 octets",
+			input:  "a@" + strings.Repeat("b", 64) + ".net",
+			code:   CodeLabelTooLong,
+			offset: 2,
+		},
+		{
+			name:   "second label of 64 octets",
+			input:  "a@test." + strings.Repeat("b", 64),
+			code:   CodeLabelTooLong,
+			offset: 7,
+		},
+		{
+			name:  "address of 254 octets",
+			input: "a@" + longDomain,
+		},
+		{
+			name:  "address of 254 octets with trailing dot",
+			input: "a@" + longDomain + ".",
+		},
+		{
+			name:   "address of 255 octets",
+			input:  "ab@" + longDomain,
+			code:   CodeAddressTooLong,
+			offset: 254,
+		},
+		{
+			name:   "domain of 254 octets",
+			input:  "a@" + strings.Repeat(label+".", 3) + strings.Repeat("c", 62),
+			code:   CodeDomainTooLong,
+			offset: 2,
+		},
+		{
+			name:   "internationalized label counted in punycode",
+			input:  "a@" + strings.Repeat("b", 58) + "ü.example",
+			code:   CodeLabelTooLong,
+			offset: 2,
+		},
+		{
+			name:    "profile raise the local part limit",
+			input:   strings.Repeat("a", 100) + "@test.net",
+			profile: Profile{Name: "long", Limits: Limits{LocalPart: 128, Address: 320}},
+		},
+		{
+			name:    "profile lower the address limit",
+			input:   strings.Repeat("a", 30) + "@test.net",
+			profile: Profile{Name: "short", Limits: Limits{Address: 32}},
+			code:    CodeAddressTooLong,
+			offset:  32,
+		},
+		{
+			name:    "address limit crossed in the quoted local part",
+			input:   `"` + strings.Repeat("a", 60) + `"@test.net`,
+			profile: Profile{Name: "short", Limits: Limits{LocalPart: 128, Address: 50}},
+			code:    CodeAddressTooLong,
+			offset:  51,
+		},
+		{
+			name:    "address limit crossed at the at sign",
+			input:   "abcdefghij@test.net",
+			profile: Profile{Name: "short", Limits: Limits{Address: 10}},
+			code:    CodeAddressTooLong,
+			offset:  10,
+		},
+		{
+			name:    "address limit crossed in internationalized domain",
+			input:   "john@bücher.example.com",
+			profile: Profile{Name: "short", Limits: Limits{Address: 20}},
+			code:    CodeAddressTooLong,
+			offset:  5,
+		},
+		{
+			name:    "profile lower the label limit",
+			input:   "a@test.network",
+			profile: Profile{Name: "short", Limits: Limits{Label: 5}},
+			code:    CodeLabelTooLong,
+			offset:  7,
+		},
+		{
+			name:    "profile can't raise the label limit above DNS",
+			input:   "a@" + strings.Repeat("b", 64) + ".net",
+			profile: Profile{Name: "dns", Limits: Limits{Label: 100}},
+			code:    CodeLabelTooLong,
+			offset:  2,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			p := c.profile
+			if p.Name == "" {
+				p = ProfileRFC5322
+			}
+			_, err := ParseWithProfile(c.input, p)
+			if c.code == "" {
+				if nil != err {
+					st.Errorf("we are not expecting error, however we got:%s", err)
+				}
+				return
+			}
+			var pe *ParseError
+			if !errors.As(err, &pe) {
+				st.Fatalf("we are expecting %s, however we got %v", c.code, err)
+			}
+			if pe.Code != c.code || pe.Offset != c.offset {
+				st.Errorf("we are expecting %s at %d, however we got %s at %d: %s", c.code, c.offset, pe.Code, pe.Offset, pe)
+			}
+			if c.profile.Name == "" && Valid(c.input) {
+				st.Errorf("Valid accept %s, however Parse doesn't", c.input)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package emailaddress
+
+import (
+	"fmt"
+	"strings"
+)
+
+// splitList split s on the commas which are not quoted, escaped or in a comment,
+// leading and trailing white spaces of each element are trimmed
+func splitList(s string) []string {
+	var elements []string
+	inQuotation := false
+	inComment := false
+	escape := 0
+	start := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		escaped := escape%2 == 1
+		switch {
+		case escaped:
+		case c == '"' && !inComment:
+			inQuotation = !inQuotation
+		case c == '(' && !inQuotation:
+			inComment = true
+		case c == ')' && !inQuotation:
+			inComment = false
+		case c == ',' && !inQuotation && !inComment:
+			elements = append(elements, strings.Trim(s[start:i], " \t"))
+			start = i + 1
+		}
+		if c == byteEscape {
+			escape++
+		} else {
+			escape = 0
+		}
+	}
+	return append(elements, strings.Trim(s[start:], " \t"))
+}
+
+// nameAddr return the addr-spec in the angle brackets when element is a name-addr of RFC 5322 section 3.4,
+// such as Ops <ops@example.com> or "Ops, Team" <ops@example.com>, the display name is a phrase of atoms, quoted strings and comments.
+// ok is false when element has no angle bracket outside quoted strings and comments, it is an addr-spec then
+func nameAddr(element string) (addrSpec string, ok bool, err error) {
+	inQuotation, inComment, escaped, invalid := false, false, false, false
+	lt := -1
+	for i := 0; i < len(element) && lt == -1; i++ {
+		c := element[i]
+		switch {
+		case escaped:
+			escaped = false
+		case c == byteEscape:
+			escaped = true
+		case inQuotation:
+			inQuotation = c != '"'
+		case inComment:
+			inComment = c != ')'
+		case c == '"':
+			inQuotation = true
+		case c == '(':
+			inComment = true
+		case c == '<':
+			lt = i
+		case c != ' ' && c != '\t' && c != '.' && !isAtext(c):
+			invalid = true
+		}
+	}
+	if lt == -1 {
+		return "", false, nil
+	}
+	if invalid {
+		return "", true, fmt.Errorf("display name %s should be words, quoted strings or comments", strings.TrimSpace(element[:lt]))
+	}
+	if !strings.HasSuffix(element, ">") {
+		return "", true, fmt.Errorf("%s should end with '>'", element)
+	}
+	return element[lt+1 : len(element)-1], true, nil
+}
+
+// parseListElement parse an element of an address list, which is an addr-spec, or a name-addr when nameAddrs is true,
+// the address is the addr-spec in the angle brackets then
+func parseListElement(element string, nameAddrs bool) (*Address, error) {
+	if nameAddrs {
+		addrSpec, ok, err := nameAddr(element)
+		if nil != err {
+			return nil, err
+		}
+		if ok {
+			return parseEmailAddress(addrSpec)
+		}
+	}
+	return parseEmailAddress(element)
+}
+
+// ParseList parses a comma separated list of email addresses, as the address-list of RFC 5322 section 3.4.
+// Each element is an addr-spec, or a name-addr such as Ops <ops@example.com>, whose address is the addr-spec in the angle brackets,
+// the display name is dropped. Commas in quoted strings, comments or escaped are not separators.
+// Empty elements are skipped as obs-addr-list allows, and an error is returned when the list has no address at all
+// or any of the element is not a legitimate email address
+func ParseList(s string) ([]*Address, error) {
+	return parseList(s, true)
+}
+
+// parseList is ParseList, name-addrs are rejected when nameAddrs is false, as a mailto URI only allows addr-specs
+func parseList(s string, nameAddrs bool) ([]*Address, error) {
+	var addresses []*Address
+	for idx, element := range splitList(s) {
+		if len(element) == 0 {
+			continue
+		}
+		e, err := parseListElement(element, nameAddrs)
+		if nil != err {
+			return nil, fmt.Errorf("element %d of the address list is invalid: %w", idx+1, err)
+		}
+		addresses = append(addresses, e)
+	}
+	if len(addresses) == 0 {
+		return nil, fmt.Errorf("empty address list")
+	}
+	return addresses, nil
+}
@@ -0,0 +1,108 @@
+package emailaddress
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestParseList(t *testing.T) {
+	cases := []struct {
+		name           string
+		input          string
+		expectedResult []string
+		err            error
+	}{
+		{
+			name:           "single",
+			input:          "test@test.net",
+			expectedResult: []string{"test@test.net"},
+		},
+		{
+			name:           "multiple",
+			input:          "test@test.net, johnny+tag@example.com ,\tx@example.com",
+			expectedResult: []string{"test@test.net", "johnny+tag@example.com", "x@example.com"},
+		},
+		{
+			name:           "comma in quotation",
+			input:          `"Bloggs, Fred"@example.com,test@test.net`,
+			expectedResult: []string{`"Bloggs, Fred"@example.com`, "test@test.net"},
+		},
+		{
+			name:           "comma in comment",
+			input:          `test(a,b)@test.net,x@example.com`,
+			expectedResult: []string{`test(a,b)@test.net`, "x@example.com"},
+		},
+		{
+			name:           "escaped comma",
+			input:          `a\,b@test.net,x@example.com`,
+			expectedResult: []string{`a\,b@test.net`, "x@example.com"},
+		},
+		{
+			name:           "empty elements",
+			input:          ",test@test.net,,x@example.com,",
+			expectedResult: []string{"test@test.net", "x@example.com"},
+		},
+		{
+			name:           "name-addr",
+			input:          "Ops <ops@example.com>, dev@example.com",
+			expectedResult: []string{"ops@example.com", "dev@example.com"},
+		},
+		{
+			name:           "quoted display name with comma",
+			input:          `"Ops, Team" <ops@example.com>,<dev@example.com>,John (work) Smith <"john smith"@example.com>`,
+			expectedResult: []string{"ops@example.com", "dev@example.com", `"john smith"@example.com`},
+		},
+		{
+			name:           "angle bracket in quoted local part",
+			input:          `"a<b"@example.com`,
+			expectedResult: []string{`"a<b"@example.com`},
+		},
+		{
+			name:  "unclosed angle-addr",
+			input: "Ops <ops@example.com",
+			err:   fmt.Errorf("element 1 of the address list is invalid: Ops <ops@example.com should end with '>'"),
+		},
+		{
+			name:  "invalid display name",
+			input: "ops@example.com <ops@example.com>",
+			err:   fmt.Errorf("element 1 of the address list is invalid: display name ops@example.com should be words, quoted strings or comments"),
+		},
+		{
+			name:  "empty list",
+			input: " , ",
+			err:   fmt.Errorf("empty address list"),
+		},
+		{
+			name:  "invalid element",
+			input: "test@test.net,test@",
+			err:   fmt.Errorf("element 2 of the address list is invalid: domain part can't be empty"),
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			addresses, err := ParseList(c.input)
+			if nil != err && c.err == nil {
+				st.Errorf("we are not expecting error , however we got:%s", err)
+				st.FailNow()
+			}
+			if nil == err && c.err != nil {
+				st.Errorf("we are expecting err:%s, however we got nil", c.err)
+				st.FailNow()
+			}
+			if c.err != nil {
+				if c.err.Error() != err.Error() {
+					st.Errorf("we are expecting err:%s,however we got :%s", c.err, err)
+				}
+				return
+			}
+			var result []string
+			for _, e := range addresses {
+				result = append(result, e.Raw())
+			}
+			if !reflect.DeepEqual(result, c.expectedResult) {
+				st.Errorf("we are expecting %v , however we got : %v", c.expectedResult, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package emailaddress
+
+import (
+	"fmt"
+	"net/textproto"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+const mailtoScheme = "mailto:"
+
+// Mailto is a mailto URI as RFC 6068
+type Mailto struct {
+	// To are the recipients, both the ones before '?' and the ones in to header fields
+	To  []*Address
+	Cc  []*Address
+	Bcc []*Address
+	// Subject and Body are the decoded subject and body header fields, line breaks in body are CRLF as RFC 6068 says,
+	// bare LF and CR are normalized when the body is parsed and when it is encoded
+	Subject string
+	Body    string
+	// Headers are the other header fields, the keys are in canonical MIME header form
+	Headers map[string][]string
+}
+
+// ParseMailtoURI parses a mailto URI as RFC 6068, such as mailto:john@example.com?cc=jane@example.org&subject=Hello%20World.
+// Everything is percent-decoded, and every recipient should be a legitimate email address,
+// an error is returned otherwise. A mailto URI without any recipient is allowed
+func ParseMailtoURI(uri string) (*Mailto, error) {
+	if len(uri) < len(mailtoScheme) || !strings.EqualFold(uri[:len(mailtoScheme)], mailtoScheme) {
+		return nil, fmt.Errorf("%s is not a mailto URI", uri)
+	}
+	to, hfields, _ := strings.Cut(uri[len(mailtoScheme):], "?")
+	m := &Mailto{}
+	if err := m.addRecipients("to", to); nil != err {
+		return nil, err
+	}
+	if len(hfields) == 0 {
+		return m, nil
+	}
+	subject, body := false, false
+	for _, hfield := range strings.Split(hfields, "&") {
+		if len(hfield) == 0 {
+			continue
+		}
+		name, value, ok := strings.Cut(hfield, "=")
+		if !ok {
+			return nil, fmt.Errorf("header field %s of the mailto URI has no value", hfield)
+		}
+		decoded, err := url.PathUnescape(name)
+		if nil != err {
+			return nil, fmt.Errorf("fail to decode header field name %s: %w", name, err)
+		}
+		name = decoded
+		switch strings.ToLower(name) {
+		case "to", "cc", "bcc":
+			if err := m.addRecipients(strings.ToLower(name), value); nil != err {
+				return nil, err
+			}
+			continue
+		}
+		if value, err = url.PathUnescape(value); nil != err {
+			return nil, fmt.Errorf("fail to decode header field %s: %w", name, err)
+		}
+		switch strings.ToLower(name) {
+		case "subject":
+			// only the first subject and body are taken
+			if !subject {
+				m.Subject, subject = value, true
+			}
+		case "body":
+			if !body {
+				m.Body, body = crlf(value), true
+			}
+		default:
+			if nil == m.Headers {
+				m.Headers = make(map[string][]string)
+			}
+			key := textproto.CanonicalMIMEHeaderKey(name)
+			m.Headers[key] = append(m.Headers[key], value)
+		}
+	}
+	return m, nil
+}
+
+// addRecipients decode the percent-encoded address list, and add the addresses to the header field name
+func (m *Mailto) addRecipients(name string, encoded string) error {
+	if len(encoded) == 0 {
+		return nil
+	}
+	decoded, err := url.PathUnescape(encoded)
+	if nil != err {
+		return fmt.Errorf("fail to decode %s recipients of the mailto URI: %w", name, err)
+	}
+	if len(strings.Trim(decoded, " \t,")) == 0 {
+		return nil
+	}
+	addresses, err := parseList(decoded, false)
+	if nil != err {
+		return fmt.Errorf("invalid %s recipients of the mailto URI: %w", name, err)
+	}
+	switch name {
+	case "to":
+		m.To = append(m.To, addresses...)
+	case "cc":
+		m.Cc = append(m.Cc, addresses...)
+	case "bcc":
+		m.Bcc = append(m.Bcc, addresses...)
+	}
+	return nil
+}
+
+// String return the mailto URI, the recipients are in canonical form, and everything is percent-encoded as RFC 6068 requires.
+// The header fields are in the order of cc, bcc, subject, body and then the others sorted by name
+func (m *Mailto) String() string {
+	var sb strings.Builder
+	sb.WriteString(mailtoScheme)
+	sb.WriteString(mailtoAddresses(m.To))
+	var hfields []string
+	if len(m.Cc) > 0 {
+		hfields = append(hfields, "cc="+mailtoAddresses(m.Cc))
+	}
+	if len(m.Bcc) > 0 {
+		hfields = append(hfields, "bcc="+mailtoAddresses(m.Bcc))
+	}
+	if len(m.Subject) > 0 {
+		hfields = append(hfields, "subject="+mailtoEscape(m.Subject, false))
+	}
+	if len(m.Body) > 0 {
+		hfields = append(hfields, "body="+mailtoEscape(crlf(m.Body), false))
+	}
+	names := make([]string, 0, len(m.Headers))
+	for name := range m.Headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, value := range m.Headers[name] {
+			hfields = append(hfields, mailtoEscape(name, true)+"="+mailtoEscape(value, false))
+		}
+	}
+	if len(hfields) > 0 {
+		sb.WriteByte('?')
+		sb.WriteString(strings.Join(hfields, "&"))
+	}
+	return sb.String()
+}
+
+// MailtoURI return the mailto URI of the given recipients
+func MailtoURI(to ...*Address) string {
+	return (&Mailto{To: to}).String()
+}
+
+func mailtoAddresses(addresses []*Address) string {
+	encoded := make([]string, len(addresses))
+	for i, e := range addresses {
+		encoded[i] = mailtoEscape(e.Canonical(), true)
+	}
+	return strings.Join(encoded, ",")
+}
+
+// crlf normalize the bare LF and CR line breaks to CRLF, as RFC 6068 requires line breaks in body to be encoded as %0D%0A
+func crlf(s string) string {
+	if !strings.ContainsAny(s, "\r\n") {
+		return s
+	}
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = strings.ReplaceAll(s, "\r", "\n")
+	return strings.ReplaceAll(s, "\n", "\r\n")
+}
+
+// mailtoEscape percent-encode everything but unreserved and some-delims of RFC 6068,
+// commas are encoded as well in strict mode, as they separate the addresses
+func mailtoEscape(s string, strict bool) string {
+	const hex = "0123456789ABCDEF"
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if isLetterDigit(c) || strings.IndexByte("-._~!$'()*+;:@", c) >= 0 || c == ',' && !strict {
+			sb.WriteByte(c)
+			continue
+		}
+		sb.WriteByte('%')
+		sb.WriteByte(hex[c>>4])
+		sb.WriteByte(hex[c&0x0f])
+	}
+	return sb.String()
+}
@@ -0,0 +1,177 @@
+package emailaddress
+
+import (
+	"reflect"
+	"testing"
+)
+
+func addressStrings(addresses []*Address) []string {
+	var result []string
+	for _, e := range addresses {
+		result = append(result, e.Raw())
+	}
+	return result
+}
+
+func TestParseMailtoURI(t *testing.T) {
+	cases := []struct {
+		name            string
+		input           string
+		expectedTo      []string
+		expectedCc      []string
+		expectedBcc     []string
+		expectedSubject string
+		expectedBody    string
+		expectedHeaders map[string][]string
+		expectedErr     bool
+	}{
+		{
+			name:       "simple",
+			input:      "mailto:chris@example.com",
+			expectedTo: []string{"chris@example.com"},
+		},
+		{
+			name:            "subject",
+			input:           "MAILTO:infobot@example.com?subject=current-issue",
+			expectedTo:      []string{"infobot@example.com"},
+			expectedSubject: "current-issue",
+		},
+		{
+			name:         "body with line breaks",
+			input:        "mailto:infobot@example.com?body=send%20current-issue%0D%0Asend%20index",
+			expectedTo:   []string{"infobot@example.com"},
+			expectedBody: "send current-issue\r\nsend index",
+		},
+		{
+			name:            "in-reply-to",
+			input:           "mailto:list@example.org?In-Reply-To=%3C3469A91.D10AF4C@example.com%3E",
+			expectedTo:      []string{"list@example.org"},
+			expectedHeaders: map[string][]string{"In-Reply-To": {"<3469A91.D10AF4C@example.com>"}},
+		},
+		{
+			name:         "recipients in header fields",
+			input:        "mailto:?to=joe@example.com&cc=bob@example.com&body=hello&bcc=a@example.com,b@example.com",
+			expectedTo:   []string{"joe@example.com"},
+			expectedCc:   []string{"bob@example.com"},
+			expectedBcc:  []string{"a@example.com", "b@example.com"},
+			expectedBody: "hello",
+		},
+		{
+			name:       "multiple recipients",
+			input:      "mailto:joe@example.com,%22bloggs,%20fred%22@example.com?to=jane@example.org",
+			expectedTo: []string{"joe@example.com", `"bloggs, fred"@example.com`, "jane@example.org"},
+		},
+		{
+			name:       "percent encoded local part",
+			input:      "mailto:%22not%40me%22@example.org",
+			expectedTo: []string{`"not@me"@example.org`},
+		},
+		{
+			name:            "no recipient",
+			input:           "mailto:?subject=hi%20there",
+			expectedSubject: "hi there",
+		},
+		{
+			name:        "not mailto",
+			input:       "http://example.com",
+			expectedErr: true,
+		},
+		{
+			name:        "name-addr is not allowed",
+			input:       "mailto:Ops%20%3Cops@example.com%3E",
+			expectedErr: true,
+		},
+		{
+			name:        "invalid recipient",
+			input:       "mailto:not-an-address",
+			expectedErr: true,
+		},
+		{
+			name:        "invalid cc",
+			input:       "mailto:joe@example.com?cc=joe@@example.com",
+			expectedErr: true,
+		},
+		{
+			name:        "invalid percent encoding",
+			input:       "mailto:joe%zz@example.com",
+			expectedErr: true,
+		},
+		{
+			name:        "header field without value",
+			input:       "mailto:joe@example.com?subject",
+			expectedErr: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			m, err := ParseMailtoURI(c.input)
+			if c.expectedErr {
+				if nil == err {
+					st.Errorf("we are expecting error, however we got nil")
+				}
+				return
+			}
+			if nil != err {
+				st.Errorf("we are not expecting error , however we got:%s", err)
+				st.FailNow()
+			}
+			if to := addressStrings(m.To); !reflect.DeepEqual(to, c.expectedTo) {
+				st.Errorf("we are expecting to %q , however we got : %q", c.expectedTo, to)
+			}
+			if cc := addressStrings(m.Cc); !reflect.DeepEqual(cc, c.expectedCc) {
+				st.Errorf("we are expecting cc %q , however we got : %q", c.expectedCc, cc)
+			}
+			if bcc := addressStrings(m.Bcc); !reflect.DeepEqual(bcc, c.expectedBcc) {
+				st.Errorf("we are expecting bcc %q , however we got : %q", c.expectedBcc, bcc)
+			}
+			if m.Subject != c.expectedSubject {
+				st.Errorf("we are expecting subject %q , however we got : %q", c.expectedSubject, m.Subject)
+			}
+			if m.Body != c.expectedBody {
+				st.Errorf("we are expecting body %q , however we got : %q", c.expectedBody, m.Body)
+			}
+			if !reflect.DeepEqual(m.Headers, c.expectedHeaders) {
+				st.Errorf("we are expecting headers %v , however we got : %v", c.expectedHeaders, m.Headers)
+			}
+		})
+	}
+}
+
+func TestMailtoString(t *testing.T) {
+	to, err := ParseList(`"Bloggs, Fred"@example.com, John(comment)@Bücher.example`)
+	if nil != err {
+		t.Fatalf("we are not expecting error , however we got:%s", err)
+	}
+	cc, _ := Parse("jane+tag@example.org")
+	m := &Mailto{
+		To:      to,
+		Cc:      []*Address{cc},
+		Subject: "100% sure?",
+		Body:    "line 1\r\nline 2 & more",
+		Headers: map[string][]string{"In-Reply-To": {"<id@example.com>"}},
+	}
+	expected := "mailto:%22Bloggs%2C%20Fred%22@example.com,John@xn--bcher-kva.example" +
+		"?cc=jane+tag@example.org&subject=100%25%20sure%3F&body=line%201%0D%0Aline%202%20%26%20more" +
+		"&In-Reply-To=%3Cid@example.com%3E"
+	if uri := m.String(); uri != expected {
+		t.Errorf("we are expecting %s , however we got : %s", expected, uri)
+	}
+	parsed, err := ParseMailtoURI(m.String())
+	if nil != err {
+		t.Fatalf("we are not expecting error , however we got:%s", err)
+	}
+	if parsed.String() != expected || parsed.Subject != m.Subject || parsed.Body != m.Body {
+		t.Errorf("we are expecting the mailto URI to round-trip, however we got : %s", parsed.String())
+	}
+	for _, body := range []string{"a\nb", "a\rb", "a\r\nb"} {
+		if uri := (&Mailto{Body: body}).String(); uri != "mailto:?body=a%0D%0Ab" {
+			t.Errorf("we are expecting the line break of %q to be encoded as %%0D%%0A, however we got : %s", body, uri)
+		}
+	}
+	if parsed, _ := ParseMailtoURI("mailto:?body=a%0Ab"); parsed.Body != "a\r\nb" {
+		t.Errorf("we are expecting the line break to be normalized to CRLF, however we got : %q", parsed.Body)
+	}
+	if uri := MailtoURI(cc); uri != "mailto:jane+tag@example.org" {
+		t.Errorf("we are expecting mailto:jane+tag@example.org , however we got : %s", uri)
+	}
+}
@@ -0,0 +1,107 @@
+package emailaddress
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// obfuscatedAtWords are the words people write in place of '@', in English, Spanish, Portuguese, French, Italian,
+// German, Dutch, Swedish, Danish and Russian
+var obfuscatedAtWords = []string{"at", "arroba", "arobase", "chiocciola", "klammeraffe", "apenstaartje", "snabel-a", "snabela", "собака"}
+
+// obfuscatedDotWords are the words people write in place of '.', in the same languages as obfuscatedAtWords
+var obfuscatedDotWords = []string{"dot", "punto", "ponto", "point", "punkt", "punt", "prick", "точка"}
+
+var (
+	// obfuscatedSeparator match one obfuscated '@' or '.', either in brackets like [at] (dot), or a bare word surrounded by spaces
+	obfuscatedSeparator = regexp.MustCompile(`(?i)\s*[\[({<]\s*(?:@|\.|` + wordsPattern(obfuscatedAtWords) + `|` + wordsPattern(obfuscatedDotWords) + `)\s*[\])}>]\s*` +
+		`|\s+(?:` + wordsPattern(obfuscatedAtWords) + `|` + wordsPattern(obfuscatedDotWords) + `)\s+`)
+	// obfuscatedAddress match a whole address in which '@' and '.' may be obfuscated
+	obfuscatedAddress = regexp.MustCompile(obfuscatedAddressPattern())
+)
+
+func wordsPattern(words []string) string {
+	quoted := make([]string, len(words))
+	for i, w := range words {
+		quoted[i] = regexp.QuoteMeta(w)
+	}
+	return strings.Join(quoted, "|")
+}
+
+func obfuscatedAddressPattern() string {
+	word := `[\p{L}\p{N}_+\-]+`
+	at := `(?:\s*[\[({<]\s*(?:@|` + wordsPattern(obfuscatedAtWords) + `)\s*[\])}>]\s*|\s+(?:` + wordsPattern(obfuscatedAtWords) + `)\s+|@)`
+	dot := `(?:\s*[\[({<]\s*(?:\.|` + wordsPattern(obfuscatedDotWords) + `)\s*[\])}>]\s*|\s+(?:` + wordsPattern(obfuscatedDotWords) + `)\s+|\.)`
+	return `(?i)` + word + `(?:` + dot + word + `)*` + at + word + `(?:` + dot + word + `)+`
+}
+
+// extractObfuscated find the addresses in which at least one '@' or '.' is obfuscated, see deobfuscate for bareWords
+func extractObfuscated(text string, bareWords bool) []Match {
+	var matches []Match
+	for _, span := range obfuscatedAddress.FindAllStringIndex(text, -1) {
+		address, ok := deobfuscate(text[span[0]:span[1]], bareWords)
+		if !ok {
+			continue
+		}
+		e, err := parseEmailAddress(address)
+		if nil != err {
+			continue
+		}
+		matches = append(matches, Match{
+			Address:    e,
+			Start:      span[0],
+			End:        span[1],
+			Obfuscated: true,
+		})
+	}
+	return matches
+}
+
+// deobfuscate replace the obfuscated separators in s, it return false when nothing is obfuscated,
+// or when '@' is a bare word and no separator is in brackets, as "look at example dot com" is rather a sentence than an address,
+// unless bareWords is set, or when '@' is a bare word and there are literal dots, as "look at example.com" is a sentence too
+func deobfuscate(s string, bareWords bool) (string, bool) {
+	replaced, bareAt, bracketed := false, false, false
+	result := obfuscatedSeparator.ReplaceAllStringFunc(s, func(sep string) string {
+		replaced = true
+		word := strings.ToLower(strings.Trim(sep, " \t\r\n[](){}<>"))
+		inBrackets := len(strings.TrimSpace(sep)) != len(word)
+		bracketed = bracketed || inBrackets
+		if word == "@" || slices.Contains(obfuscatedAtWords, word) {
+			bareAt = !inBrackets
+			return "@"
+		}
+		return "."
+	})
+	if !replaced || strings.Count(result, "@") != 1 {
+		return "", false
+	}
+	if bareAt && !bracketed && !bareWords {
+		return "", false
+	}
+	if bareAt && strings.Contains(obfuscatedSeparator.ReplaceAllString(s, ""), ".") {
+		return "", false
+	}
+	return result, true
+}
+
+// mergeMatches add the obfuscated matches which don't overlap with the plain ones, and keep them ordered by offset
+func mergeMatches(plain []Match, obfuscated []Match) []Match {
+	if len(obfuscated) == 0 {
+		return plain
+	}
+	result := make([]Match, 0, len(plain)+len(obfuscated))
+	i := 0
+	for _, m := range obfuscated {
+		for i < len(plain) && plain[i].End <= m.Start {
+			result = append(result, plain[i])
+			i++
+		}
+		if i < len(plain) && plain[i].Start < m.End {
+			continue
+		}
+		result = append(result, m)
+	}
+	return append(result, plain[i:]...)
+}
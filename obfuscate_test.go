@@ -0,0 +1,149 @@
+package emailaddress
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtractDeobfuscate(t *testing.T) {
+	cases := []struct {
+		name           string
+		input          string
+		expectedResult []string
+	}{
+		{
+			name:           "brackets",
+			input:          "Contact: john [at] example [dot] com",
+			expectedResult: []string{"john@example.com"},
+		},
+		{
+			name:           "parentheses without spaces",
+			input:          "john(at)example(dot)com",
+			expectedResult: []string{"john@example.com"},
+		},
+		{
+			name:           "bare words with a bracketed separator",
+			input:          "mail john dot smith at example [dot] co dot uk for details",
+			expectedResult: []string{"john.smith@example.co.uk"},
+		},
+		{
+			name:           "upper case",
+			input:          "JOHN [AT] EXAMPLE DOT COM",
+			expectedResult: []string{"JOHN@EXAMPLE.COM"},
+		},
+		{
+			name:           "symbols in brackets",
+			input:          "john{@}example[.]com",
+			expectedResult: []string{"john@example.com"},
+		},
+		{
+			name:           "obfuscated at only",
+			input:          "john [at] example.com.",
+			expectedResult: []string{"john@example.com"},
+		},
+		{
+			name:           "spanish",
+			input:          "escribe a juan arroba ejemplo (punto) es",
+			expectedResult: []string{"juan@ejemplo.es"},
+		},
+		{
+			name:           "german",
+			input:          "max (at) beispiel (punkt) de",
+			expectedResult: []string{"max@beispiel.de"},
+		},
+		{
+			name:           "russian",
+			input:          "ivan (собака) example точка ru",
+			expectedResult: []string{"ivan@example.ru"},
+		},
+		{
+			name:           "bare at with literal dot is a sentence",
+			input:          "have a look at example.com",
+			expectedResult: nil,
+		},
+		{
+			name:           "bare words only are a sentence",
+			input:          "mail john dot smith at example dot co dot uk for details",
+			expectedResult: nil,
+		},
+		{
+			name:           "look at example dot com",
+			input:          "Look at example dot com",
+			expectedResult: nil,
+		},
+		{
+			name:           "met at home point five",
+			input:          "we met at home point five",
+			expectedResult: nil,
+		},
+		{
+			name:           "spanish sentence",
+			input:          "nos vemos en casa arroba cinco punto dos",
+			expectedResult: nil,
+		},
+		{
+			name:           "literal at with bare dot",
+			input:          "john@example dot com",
+			expectedResult: []string{"john@example.com"},
+		},
+		{
+			name:           "no dot in domain",
+			input:          "meet me at home",
+			expectedResult: nil,
+		},
+		{
+			name:           "mixed with plain addresses",
+			input:          "jane@example.org, john [at] example [dot] com; bob@example.net",
+			expectedResult: []string{"jane@example.org", "john@example.com", "bob@example.net"},
+		},
+		{
+			name:           "invalid domain",
+			input:          "john [at] -example [dot] com",
+			expectedResult: nil,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			var result []string
+			for _, m := range ExtractWithOptions(c.input, ExtractOptions{Deobfuscate: true}) {
+				if m.Obfuscated != (c.input[m.Start:m.End] != m.Address.Raw()) {
+					st.Errorf("span %s of %s is not expected to have obfuscated=%t", c.input[m.Start:m.End], m.Address.Raw(), m.Obfuscated)
+				}
+				result = append(result, m.Address.Raw())
+			}
+			if !reflect.DeepEqual(result, c.expectedResult) {
+				st.Errorf("we are expecting %q , however we got : %q", c.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestExtractBareWords(t *testing.T) {
+	cases := map[string][]string{
+		"user at example dot com":                           {"user@example.com"},
+		"mail john dot smith at example dot co dot uk":      {"john.smith@example.co.uk"},
+		"juan arroba ejemplo punto es":                      {"juan@ejemplo.es"},
+		"have a look at example.com":                        nil,
+		"meet me at home":                                   nil,
+		"john [at] example [dot] com and user at x dot org": {"john@example.com", "user@x.org"},
+	}
+	opts := ExtractOptions{Deobfuscate: true, BareWords: true}
+	for input, expected := range cases {
+		var result []string
+		for _, m := range ExtractWithOptions(input, opts) {
+			result = append(result, m.Address.Raw())
+		}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("%s: we are expecting %q , however we got : %q", input, expected, result)
+		}
+	}
+	if matches := ExtractWithOptions("user at example dot com", ExtractOptions{BareWords: true}); len(matches) != 0 {
+		t.Errorf("we are not expecting BareWords to work without Deobfuscate, however we got %d", len(matches))
+	}
+}
+
+func TestExtractWithoutDeobfuscate(t *testing.T) {
+	if matches := Extract("john [at] example [dot] com"); len(matches) != 0 {
+		t.Errorf("we are not expecting obfuscated addresses to be extracted by default, however we got %d", len(matches))
+	}
+}
@@ -0,0 +1,104 @@
+package emailaddress
+
+import (
+	"slices"
+	"strings"
+)
+
+// Profile is a set of rules an email address is validated against, on top of the syntax Parse accepts
+type Profile struct {
+	// Name of the profile
+	Name string
+	// AllowComments allow comments in the local part, RFC 5322 allows them, but they are not part of the mailbox
+	AllowComments bool
+	// AllowQuoted allow quoted strings and escaped characters in the local part
+	AllowQuoted bool
+	// AllowUnicode allow non-ASCII characters in the local part and internationalized domain names
+	AllowUnicode bool
+	// AllowAddressLiteral allow an address literal such as [192.0.2.1] as the domain
+	AllowAddressLiteral bool
+	// WarningsAsErrors are the warnings which make an address invalid, see Address.Warnings.
+	// The code of the error is the code of the warning
+	WarningsAsErrors []WarningCode
+	// Limits are the length limits of the address, the zero fields take the value of DefaultLimits
+	Limits Limits
+}
+
+var (
+	// ProfileRFC5322 accept everything Parse accepts, it is the default profile
+	ProfileRFC5322 = Profile{
+		Name:                "rfc5322",
+		AllowComments:       true,
+		AllowQuoted:         true,
+		AllowUnicode:        true,
+		AllowAddressLiteral: true,
+	}
+	// ProfileRFC5321 is for the addresses on the SMTP envelope, comments and non-ASCII characters are not allowed
+	ProfileRFC5321 = Profile{
+		Name:                "rfc5321",
+		AllowQuoted:         true,
+		AllowAddressLiteral: true,
+	}
+	// ProfileHTML5 follow the valid email address of HTML input type=email, the local part should be a dot-atom,
+	// and the domain should be a domain name
+	ProfileHTML5 = Profile{
+		Name: "html5",
+	}
+	profiles = []Profile{ProfileRFC5322, ProfileRFC5321, ProfileHTML5}
+)
+
+// LookupProfile return the predefined profile by name, the name is case-insensitive
+func LookupProfile(name string) (Profile, bool) {
+	for _, p := range profiles {
+		if strings.EqualFold(p.Name, name) {
+			return p, true
+		}
+	}
+	return Profile{}, false
+}
+
+// ProfileNames return the names of the predefined profiles
+func ProfileNames() []string {
+	names := make([]string, 0, len(profiles))
+	for _, p := range profiles {
+		names = append(names, p.Name)
+	}
+	return names
+}
+
+// check whether e is acceptable by the profile
+func (p Profile) check(e *Address) error {
+	if !p.AllowComments && len(e.lp.comment) > 0 {
+		return newParseError(CodeCommentNotAllowed, strings.IndexByte(e.raw, '('), "comment is not allowed by profile %s", p.Name)
+	}
+	if !p.AllowQuoted && e.lp.isQuoted() {
+		return newParseError(CodeQuotedNotAllowed, strings.IndexAny(e.raw, `"\`), "quoted string and escape are not allowed by profile %s", p.Name)
+	}
+	if !p.AllowUnicode && !isASCII(e.raw) {
+		return newParseError(CodeNonASCIINotAllowed, nonASCIIOffset(e.raw), "non-ASCII character is not allowed by profile %s", p.Name)
+	}
+	if !p.AllowAddressLiteral && e.dom.IsIP() {
+		return newParseError(CodeAddressLiteralNotAllowed, len(e.raw)-len(e.domain), "address literal is not allowed by profile %s", p.Name)
+	}
+	if len(p.WarningsAsErrors) == 0 {
+		return nil
+	}
+	for _, w := range e.Warnings() {
+		if slices.Contains(p.WarningsAsErrors, w.Code) {
+			return newParseError(ErrorCode(w.Code), w.Offset, "warning %s is an error in profile %s: %s", w.Code, p.Name, w.Msg)
+		}
+	}
+	return nil
+}
+
+// ParseWithProfile parse the given email address, and then check it against the given profile
+func ParseWithProfile(emailAddress string, profile Profile) (*Address, error) {
+	e, err := parseWithLimits(emailAddress, profile.Limits.withDefaults())
+	if nil != err {
+		return nil, err
+	}
+	if err := profile.check(e); nil != err {
+		return nil, err
+	}
+	return e, nil
+}
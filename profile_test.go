@@ -0,0 +1,96 @@
+package emailaddress
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParseWithProfile(t *testing.T) {
+	cases := []struct {
+		name    string
+		input   string
+		profile string
+		err     error
+	}{
+		{
+			name:    "rfc5322 comment",
+			input:   "john.smith(comment)@example.com",
+			profile: "rfc5322",
+		},
+		{
+			name:    "rfc5321 comment",
+			input:   "john.smith(comment)@example.com",
+			profile: "rfc5321",
+			err:     fmt.Errorf("comment is not allowed by profile rfc5321"),
+		},
+		{
+			name:    "rfc5321 quoted",
+			input:   `"Fred Bloggs"@example.com`,
+			profile: "RFC5321",
+		},
+		{
+			name:    "rfc5321 unicode",
+			input:   "johnny@bücher.example",
+			profile: "rfc5321",
+			err:     fmt.Errorf("non-ASCII character is not allowed by profile rfc5321"),
+		},
+		{
+			name:    "html5 quoted",
+			input:   `"Fred Bloggs"@example.com`,
+			profile: "html5",
+			err:     fmt.Errorf("quoted string and escape are not allowed by profile html5"),
+		},
+		{
+			name:    "html5 escaped",
+			input:   `Abc\@def@example.com`,
+			profile: "html5",
+			err:     fmt.Errorf("quoted string and escape are not allowed by profile html5"),
+		},
+		{
+			name:    "html5 tags",
+			input:   "user.name+tag+sorting@example.com",
+			profile: "html5",
+		},
+		{
+			name:    "rfc5321 address literal",
+			input:   "postmaster@[192.0.2.1]",
+			profile: "rfc5321",
+		},
+		{
+			name:    "html5 address literal",
+			input:   "postmaster@[192.0.2.1]",
+			profile: "html5",
+			err:     fmt.Errorf("address literal is not allowed by profile html5"),
+		},
+		{
+			name:    "invalid address",
+			input:   "test@",
+			profile: "rfc5322",
+			err:     fmt.Errorf("domain part can't be empty"),
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			p, ok := LookupProfile(c.profile)
+			if !ok {
+				st.Errorf("profile %s is not found", c.profile)
+				st.FailNow()
+			}
+			_, err := ParseWithProfile(c.input, p)
+			if nil != err && c.err == nil {
+				st.Errorf("we are not expecting error , however we got:%s", err)
+				st.FailNow()
+			}
+			if nil == err && c.err != nil {
+				st.Errorf("we are expecting err:%s, however we got nil", c.err)
+				st.FailNow()
+			}
+			if c.err != nil && c.err.Error() != err.Error() {
+				st.Errorf("we are expecting err:%s,however we got :%s", c.err, err)
+			}
+		})
+	}
+	if _, ok := LookupProfile("unknown"); ok {
+		t.Errorf("we are not expecting profile unknown")
+	}
+}
@@ -0,0 +1,310 @@
+package emailaddress
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+)
+
+const (
+	// MinPseudonymKeyLength is the minimum length of the key of a Pseudonymizer
+	MinPseudonymKeyLength = 16
+	// pseudonymRounds is the number of rounds of the Feistel network
+	pseudonymRounds = 10
+	// pseudonymDigits are the digits of the pseudonyms, lowercase base32, so every pseudonym is a valid atom and domain label
+	pseudonymDigits = "abcdefghijklmnopqrstuvwxyz234567"
+)
+
+// pseudonymAlphabets are the alphabets a part is encrypted in, the first one containing every character of the part is taken,
+// the empty one is every byte
+var pseudonymAlphabets = []string{
+	"abcdefghijklmnopqrstuvwxyz0123456789.-_",
+	"abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789.-_",
+	"",
+}
+
+// ErrPseudonymTooLong is returned when the pseudonym of an address exceeds the length limits of an address
+var ErrPseudonymTooLong = errors.New("the pseudonym exceeds the length limits of an address")
+
+// Pseudonymizer replace addresses with fake ones which still validate, the same input is always mapped to the same output
+// under the same key, and the original address can be revealed with the key.
+//
+// Every part is encrypted deterministically with a keyed Feistel network in the manner of FF1 format-preserving encryption,
+// over the smallest alphabet containing the part. It is written in lowercase base32 after two characters,
+// which tell the alphabet and check the key, so a pseudonym is about as long as the original.
+// A local part of up to 58 characters of lowercase letters, digits, '.', '-' and '_', of up to 51 characters with upper case letters,
+// or of up to 38 bytes of anything else can be pseudonymized, the tags share the limit of the local part.
+// A long domain is split into labels, so it is only limited by the length of the domain.
+// ErrPseudonymTooLong is returned when the pseudonym exceeds the length limits of an address.
+//
+// The structure of the address is preserved, a quoted local part stays quoted, and every tag is pseudonymized on its own.
+// The domains of the popular mail providers are kept as they are, which identify nobody,
+// other domains are replaced with pseudonymized labels under the same top level domain,
+// and an address literal is replaced with pseudonymized labels.
+type Pseudonymizer struct {
+	macKey []byte
+}
+
+// NewPseudonymizer create a Pseudonymizer with the secret key, which should have at least MinPseudonymKeyLength bytes
+func NewPseudonymizer(key []byte) (*Pseudonymizer, error) {
+	if len(key) < MinPseudonymKeyLength {
+		return nil, fmt.Errorf("pseudonymizer key should have at least %d bytes", MinPseudonymKeyLength)
+	}
+	macKey := hmac.New(sha256.New, key)
+	macKey.Write([]byte("emailaddress pseudonym"))
+	return &Pseudonymizer{
+		macKey: macKey.Sum(nil),
+	}, nil
+}
+
+// Pseudonymize return the pseudonym of e, comments are dropped
+func (p *Pseudonymizer) Pseudonymize(e *Address) (*Address, error) {
+	b := strings.Builder{}
+	b.WriteString(p.pseudonymizeAtom('l', e.lp.localPartEmail))
+	for _, t := range e.lp.tags {
+		b.WriteByte('+')
+		b.WriteString(p.pseudonymizeAtom('t', t.String()))
+	}
+	domain := e.canonicalDomain()
+	if !slices.Contains(popularDomains, domain) {
+		name, tld := domain, ""
+		if idx := strings.LastIndexByte(domain, '.'); idx > 0 && !e.dom.IsIP() {
+			name, tld = domain[:idx], domain[idx:]
+		}
+		domain = splitLabels(p.encrypt('d', name, len(tld) > 0)) + tld
+	}
+	pseudonym, err := parseEmailAddress(b.String() + "@" + domain)
+	if nil != err {
+		var pe *ParseError
+		if errors.As(err, &pe) && slices.Contains([]ErrorCode{CodeLocalPartTooLong, CodeDomainTooLong, CodeAddressTooLong}, pe.Code) {
+			return nil, fmt.Errorf("fail to pseudonymize %s: %w: %w", e.Canonical(), ErrPseudonymTooLong, err)
+		}
+		return nil, fmt.Errorf("fail to pseudonymize %s: %w", e.Canonical(), err)
+	}
+	return pseudonym, nil
+}
+
+// PseudonymizeString parse emailAddress, and return the canonical form of its pseudonym
+func (p *Pseudonymizer) PseudonymizeString(emailAddress string) (string, error) {
+	e, err := parseEmailAddress(emailAddress)
+	if nil != err {
+		return "", err
+	}
+	pseudonym, err := p.Pseudonymize(e)
+	if nil != err {
+		return "", err
+	}
+	return pseudonym.Canonical(), nil
+}
+
+// Reveal return the original address of the pseudonym, without comments and in minimal quoting.
+// An error is returned when pseudonym is not created by a Pseudonymizer, and most likely when it is created with another key,
+// the check is a single character to keep the pseudonyms short, so it is not an authentication
+func (p *Pseudonymizer) Reveal(pseudonym *Address) (*Address, error) {
+	local, err := p.decrypt('l', unquote(pseudonym.lp.localPartEmail))
+	if nil != err {
+		return nil, err
+	}
+	for _, t := range pseudonym.lp.tags {
+		tag, err := p.decrypt('t', unquote(t.String()))
+		if nil != err {
+			return nil, err
+		}
+		local += "+" + tag
+	}
+	domain := pseudonym.canonicalDomain()
+	if !slices.Contains(popularDomains, domain) {
+		labels := strings.Split(domain, ".")
+		tld := ""
+		if len(labels) > 1 && pseudonymFlag(labels[0]) {
+			labels, tld = labels[:len(labels)-1], "."+labels[len(labels)-1]
+		}
+		name, err := p.decrypt('d', strings.Join(labels, ""))
+		if nil != err {
+			return nil, err
+		}
+		domain = name + tld
+	}
+	return New(local, domain)
+}
+
+// pseudonymizeAtom pseudonymize the unquoted content of s, the result is quoted when s is
+func (p *Pseudonymizer) pseudonymizeAtom(kind byte, s string) string {
+	pseudonym := p.encrypt(kind, unquote(s), false)
+	if strings.ContainsAny(s, `"\`) {
+		return `"` + pseudonym + `"`
+	}
+	return pseudonym
+}
+
+// splitLabels split s into domain labels of no more than MaxLabelLength
+func splitLabels(s string) string {
+	labels := make([]string, 0, len(s)/MaxLabelLength+1)
+	for len(s) > MaxLabelLength {
+		labels = append(labels, s[:MaxLabelLength])
+		s = s[MaxLabelLength:]
+	}
+	return strings.Join(append(labels, s), ".")
+}
+
+// pseudonymAlphabet return the index of the first alphabet containing every character of s
+func pseudonymAlphabet(s string) int {
+	for idx, alphabet := range pseudonymAlphabets {
+		if len(alphabet) == 0 || len(strings.Trim(s, alphabet)) == 0 {
+			return idx
+		}
+	}
+	return len(pseudonymAlphabets) - 1
+}
+
+// pseudonymRadix return the radix of the alphabet
+func pseudonymRadix(alphabet string) int64 {
+	if len(alphabet) == 0 {
+		return 256
+	}
+	return int64(len(alphabet))
+}
+
+// pseudonymFlag return the flag given to encrypt, which is carried by the first character of the pseudonym
+func pseudonymFlag(s string) bool {
+	return len(s) > 0 && strings.IndexByte(pseudonymDigits, s[0]) >= len(pseudonymAlphabets)
+}
+
+// pseudonymLength return the number of base32 digits of a part of n characters in radix,
+// it is the smallest length which 32^length >= radix^n, it grows with n, so n can be told from it
+func pseudonymLength(radix int64, n int) int {
+	max := new(big.Int).Exp(big.NewInt(radix), big.NewInt(int64(n)), nil)
+	return (max.Sub(max, big.NewInt(1)).BitLen() + 4) / 5
+}
+
+// encrypt s of the given kind, the result start with the alphabet, which also carry flag, and the check of the key
+func (p *Pseudonymizer) encrypt(kind byte, s string, flag bool) string {
+	idx := pseudonymAlphabet(s)
+	alphabet := pseudonymAlphabets[idx]
+	radix := pseudonymRadix(alphabet)
+	x := new(big.Int)
+	for i := 0; i < len(s); i++ {
+		digit := int64(s[i])
+		if len(alphabet) > 0 {
+			digit = int64(strings.IndexByte(alphabet, s[i]))
+		}
+		x.Mul(x, big.NewInt(radix)).Add(x, big.NewInt(digit))
+	}
+	x = p.feistel(kind, radix, len(s), x, false)
+
+	header := idx
+	if flag {
+		header += len(pseudonymAlphabets)
+	}
+	digits := make([]byte, pseudonymLength(radix, len(s)))
+	base := big.NewInt(32)
+	digit := new(big.Int)
+	for i := len(digits) - 1; i >= 0; i-- {
+		x.QuoRem(x, base, digit)
+		digits[i] = pseudonymDigits[digit.Int64()]
+	}
+	return string([]byte{pseudonymDigits[header], pseudonymDigits[p.check(kind, s)]}) + string(digits)
+}
+
+// decrypt the pseudonym s of the given kind
+func (p *Pseudonymizer) decrypt(kind byte, s string) (string, error) {
+	s = strings.ToLower(s)
+	notPseudonym := fmt.Errorf("%s is not a pseudonym", s)
+	if len(s) < 2 {
+		return "", notPseudonym
+	}
+	header := strings.IndexByte(pseudonymDigits, s[0])
+	if header < 0 || header >= 2*len(pseudonymAlphabets) {
+		return "", notPseudonym
+	}
+	alphabet := pseudonymAlphabets[header%len(pseudonymAlphabets)]
+	radix := pseudonymRadix(alphabet)
+	n := 0
+	for pseudonymLength(radix, n) < len(s)-2 {
+		n++
+	}
+	if pseudonymLength(radix, n) != len(s)-2 {
+		return "", notPseudonym
+	}
+	x := new(big.Int)
+	for i := 2; i < len(s); i++ {
+		digit := strings.IndexByte(pseudonymDigits, s[i])
+		if digit < 0 {
+			return "", notPseudonym
+		}
+		x.Lsh(x, 5).Or(x, big.NewInt(int64(digit)))
+	}
+	if x.Cmp(new(big.Int).Exp(big.NewInt(radix), big.NewInt(int64(n)), nil)) >= 0 {
+		return "", notPseudonym
+	}
+	x = p.feistel(kind, radix, n, x, true)
+
+	plain := make([]byte, n)
+	base := big.NewInt(radix)
+	digit := new(big.Int)
+	for i := n - 1; i >= 0; i-- {
+		x.QuoRem(x, base, digit)
+		if len(alphabet) > 0 {
+			plain[i] = alphabet[digit.Int64()]
+		} else {
+			plain[i] = byte(digit.Int64())
+		}
+	}
+	if s[1] != pseudonymDigits[p.check(kind, string(plain))] {
+		return "", fmt.Errorf("%s is not a pseudonym of this key", s)
+	}
+	return string(plain), nil
+}
+
+// check return the base32 digit which tell whether a pseudonym is created with the key
+func (p *Pseudonymizer) check(kind byte, s string) int {
+	h := hmac.New(sha256.New, p.macKey)
+	h.Write([]byte{'c', kind})
+	h.Write([]byte(s))
+	return int(h.Sum(nil)[0] % 32)
+}
+
+// feistel encrypt or decrypt x, which is a number of n digits in radix, with the alternating Feistel network of FF1,
+// the halves are numbers of n/2 and n-n/2 digits
+func (p *Pseudonymizer) feistel(kind byte, radix int64, n int, x *big.Int, decrypt bool) *big.Int {
+	u := n / 2
+	ru := new(big.Int).Exp(big.NewInt(radix), big.NewInt(int64(u)), nil)
+	rv := new(big.Int).Exp(big.NewInt(radix), big.NewInt(int64(n-u)), nil)
+	a, b := new(big.Int).QuoRem(x, rv, new(big.Int))
+	for round := 0; round < pseudonymRounds; round++ {
+		i := round
+		if decrypt {
+			i = pseudonymRounds - 1 - round
+		}
+		m := rv
+		if i%2 == 0 {
+			m = ru
+		}
+		if decrypt {
+			// the round i turned (a', b') into (b', (a' + F(b')) mod m)
+			c := new(big.Int).Sub(b, p.round(kind, radix, n, i, a, m))
+			a, b = c.Mod(c, m), a
+			continue
+		}
+		c := new(big.Int).Add(a, p.round(kind, radix, n, i, b, m))
+		a, b = b, c.Mod(c, m)
+	}
+	return a.Mul(a, rv).Add(a, b)
+}
+
+// round is the round function of the Feistel network, it return a number in [0, m) derived from the key and the half b
+func (p *Pseudonymizer) round(kind byte, radix int64, n int, i int, b *big.Int, m *big.Int) *big.Int {
+	var stream []byte
+	for counter := byte(0); len(stream)*8 < m.BitLen()+64; counter++ {
+		h := hmac.New(sha256.New, p.macKey)
+		h.Write([]byte{'f', kind, byte(radix >> 8), byte(radix), byte(n), byte(i), counter})
+		h.Write(b.Bytes())
+		stream = h.Sum(stream)
+	}
+	y := new(big.Int).SetBytes(stream)
+	return y.Mod(y, m)
+}
@@ -0,0 +1,175 @@
+package emailaddress
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+var testPseudonymKey = []byte("0123456789abcdef0123456789abcdef")
+
+func TestPseudonymize(t *testing.T) {
+	p, err := NewPseudonymizer(testPseudonymKey)
+	if nil != err {
+		t.Fatalf("we are not expecting error , however we got:%s", err)
+	}
+	cases := []struct {
+		name           string
+		input          string
+		expectedReveal string
+		keepDomain     bool
+		tags           int
+		quoted         bool
+	}{
+		{
+			name:           "simple",
+			input:          "john.smith@acme.com",
+			expectedReveal: "john.smith@acme.com",
+		},
+		{
+			name:           "popular domain",
+			input:          "john@gmail.com",
+			expectedReveal: "john@gmail.com",
+			keepDomain:     true,
+		},
+		{
+			name:           "tags",
+			input:          "john+news+2019@mail.acme.co.uk",
+			expectedReveal: "john+news+2019@mail.acme.co.uk",
+			tags:           2,
+		},
+		{
+			name:           "quoted",
+			input:          `"john smith"(comment)@Acme.COM`,
+			expectedReveal: `"john smith"@acme.com`,
+			quoted:         true,
+		},
+		{
+			name:           "unicode",
+			input:          "jörg@bücher.de",
+			expectedReveal: "jörg@xn--bcher-kva.de",
+		},
+		{
+			name:           "single label domain",
+			input:          "root@localhost",
+			expectedReveal: "root@localhost",
+		},
+		{
+			name:           "long local part",
+			input:          strings.Repeat("a", 58) + "@acme.com",
+			expectedReveal: strings.Repeat("a", 58) + "@acme.com",
+		},
+		{
+			name:           "long mixed case local part",
+			input:          "John.Smith." + strings.Repeat("X", 40) + "@acme.com",
+			expectedReveal: "John.Smith." + strings.Repeat("X", 40) + "@acme.com",
+		},
+		{
+			name:           "long domain",
+			input:          "john@" + strings.Repeat(strings.Repeat("d", 60)+".", 3) + "com",
+			expectedReveal: "john@" + strings.Repeat(strings.Repeat("d", 60)+".", 3) + "com",
+		},
+		{
+			name:           "address literal",
+			input:          "postmaster@[192.0.2.1]",
+			expectedReveal: "postmaster@[192.0.2.1]",
+		},
+		{
+			name:           "ipv6 address literal",
+			input:          "postmaster@[IPv6:2001:db8::1]",
+			expectedReveal: "postmaster@[IPv6:2001:db8::1]",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			e, err := Parse(c.input)
+			if nil != err {
+				st.Fatalf("we are not expecting error , however we got:%s", err)
+			}
+			pseudonym, err := p.Pseudonymize(e)
+			if nil != err {
+				st.Fatalf("we are not expecting error , however we got:%s", err)
+			}
+			if ok, err := Validate(pseudonym.Canonical()); !ok {
+				st.Errorf("we are expecting the pseudonym %s to be valid, however we got %s", pseudonym, err)
+			}
+			if again, _ := p.Pseudonymize(e); again.Canonical() != pseudonym.Canonical() {
+				st.Errorf("we are expecting the same pseudonym %s , however we got : %s", pseudonym.Canonical(), again.Canonical())
+			}
+			if pseudonym.UnquotedLocalPart() == e.UnquotedLocalPart() {
+				st.Errorf("we are expecting the local part to be replaced, however we got : %s", pseudonym.Canonical())
+			}
+			if (pseudonym.canonicalDomain() == e.canonicalDomain()) != c.keepDomain {
+				st.Errorf("we are not expecting the domain %s for %s", pseudonym.Domain(), c.input)
+			}
+			if idx := strings.LastIndexByte(e.canonicalDomain(), '.'); idx > 0 && !e.ParsedDomain().IsIP() && !strings.HasSuffix(pseudonym.Domain(), e.canonicalDomain()[idx:]) {
+				tld := e.canonicalDomain()[idx:]
+				st.Errorf("we are expecting the top level domain %s to be kept, however we got : %s", tld, pseudonym.Domain())
+			}
+			if len(pseudonym.Tags()) != c.tags {
+				st.Errorf("we are expecting %d tags , however we got : %d", c.tags, len(pseudonym.Tags()))
+			}
+			if pseudonym.lp.isQuoted() != c.quoted {
+				st.Errorf("we are not expecting quoted=%t for %s", pseudonym.lp.isQuoted(), pseudonym)
+			}
+			revealed, err := p.Reveal(pseudonym)
+			if nil != err {
+				st.Fatalf("we are not expecting error , however we got:%s", err)
+			}
+			if revealed.Canonical() != c.expectedReveal {
+				st.Errorf("we are expecting %s , however we got : %s", c.expectedReveal, revealed.Canonical())
+			}
+		})
+	}
+}
+
+func TestPseudonymizeErrors(t *testing.T) {
+	if _, err := NewPseudonymizer([]byte("short")); nil == err {
+		t.Errorf("we are expecting error for a short key, however we got nil")
+	}
+	p, _ := NewPseudonymizer(testPseudonymKey)
+	other, _ := NewPseudonymizer([]byte("fedcba9876543210fedcba9876543210"))
+	pseudonym, err := p.PseudonymizeString("john@acme.com")
+	if nil != err {
+		t.Fatalf("we are not expecting error , however we got:%s", err)
+	}
+	if otherPseudonym, _ := other.PseudonymizeString("john@acme.com"); otherPseudonym == pseudonym {
+		t.Errorf("we are expecting different pseudonyms under different keys, however both are %s", pseudonym)
+	}
+	e, _ := Parse(pseudonym)
+	if _, err := other.Reveal(e); nil == err {
+		t.Errorf("we are expecting error revealing with another key, however we got nil")
+	}
+	e, _ = Parse("john@acme.com")
+	if _, err := p.Reveal(e); nil == err {
+		t.Errorf("we are expecting error revealing an address which is not a pseudonym, however we got nil")
+	}
+	if _, err := p.PseudonymizeString(strings.Repeat("a", 40) + "@acme.com"); nil != err {
+		t.Errorf("we are not expecting error for a local part of 40 characters, however we got:%s", err)
+	}
+	if _, err := p.PseudonymizeString(strings.Repeat("a", 59) + "@acme.com"); !errors.Is(err, ErrPseudonymTooLong) {
+		t.Errorf("we are expecting ErrPseudonymTooLong when the pseudonym is too long, however we got %v", err)
+	}
+	e, _ = Parse("af@acme.com")
+	if _, err := p.Reveal(e); nil == err {
+		t.Errorf("we are expecting error revealing a local part which is too short, however we got nil")
+	}
+}
+
+func TestPseudonymizeLengths(t *testing.T) {
+	p, _ := NewPseudonymizer(testPseudonymKey)
+	for n := 1; n <= 58; n++ {
+		for _, local := range []string{strings.Repeat("z", n), strings.Repeat("Z", min(n, 51)), `"` + strings.Repeat("é", (min(n, 38)+1)/2) + `"`} {
+			input := local + "@acme.com"
+			pseudonym, err := p.PseudonymizeString(input)
+			if nil != err {
+				t.Fatalf("we are not expecting error for %s, however we got:%s", input, err)
+			}
+			e, _ := Parse(pseudonym)
+			revealed, err := p.Reveal(e)
+			if nil != err || unquote(revealed.lp.localPartEmail) != unquote(local) {
+				t.Fatalf("we are expecting %s to be revealed, however we got %v %v", input, revealed, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package emailaddress
+
+import "strings"
+
+// atext are the characters can appear in a dot-atom without quoting, see RFC 5322 section 3.2.3
+const atext = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789!#$%&'*+-/=?^_`{|}~"
+
+// isAtext checks whether c can appear in a local part without quoting,
+// non-ASCII bytes are accepted as well, as RFC 6531 allows UTF-8 in the local part
+func isAtext(c byte) bool {
+	return c >= 0x80 || strings.IndexByte(atext, c) >= 0
+}
+
+// isDotAtom checks whether s can be used as the local part without quoting
+func isDotAtom(s string) bool {
+	if len(s) == 0 || s[0] == '.' || s[len(s)-1] == '.' {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '.' {
+			if s[i-1] == '.' {
+				return false
+			}
+			continue
+		}
+		if !isAtext(c) {
+			return false
+		}
+	}
+	return true
+}
+
+// QuoteLocalPart return s in the form can be used as the local part of an email address.
+// s is returned as is when it is a valid dot-atom, otherwise it is wrapped in quotation marks,
+// and the quotation marks and backslashes in it are escaped with a backslash
+func QuoteLocalPart(s string) string {
+	if isDotAtom(s) {
+		return s
+	}
+	return quote(s)
+}
+
+// quote wrap s in quotation marks, and escape the quotation marks and backslashes in it
+func quote(s string) string {
+	b := strings.Builder{}
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '"' || c == byteEscape {
+			b.WriteByte(byteEscape)
+		}
+		b.WriteByte(c)
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// New create an email address from the unquoted local part and the domain,
+// the local part will be quoted and escaped only when it is required.
+// An error is returned when the result is not a legitimate email address
+func New(local string, domain string) (*Address, error) {
+	if len(local) == 0 {
+		return nil, newParseError(CodeEmptyLocalPart, 0, "empty local part")
+	}
+	for i := 0; i < len(local); i++ {
+		c := local[i]
+		if isControl(c) && c != '\t' {
+			return nil, newParseError(CodeInvalidCharacter, i, "%q can't be represented in the local part of an email address", c)
+		}
+	}
+	return parseEmailAddress(QuoteLocalPart(local) + "@" + domain)
+}
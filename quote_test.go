@@ -0,0 +1,198 @@
+package emailaddress
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestQuoteLocalPart(t *testing.T) {
+	cases := []struct {
+		name           string
+		input          string
+		expectedResult string
+	}{
+		{
+			name:           "simple",
+			input:          "johnny",
+			expectedResult: "johnny",
+		},
+		{
+			name:           "dots and tags",
+			input:          "johnny.luo+tag",
+			expectedResult: "johnny.luo+tag",
+		},
+		{
+			name:           "special characters",
+			input:          "!def!xyz%abc",
+			expectedResult: "!def!xyz%abc",
+		},
+		{
+			name:           "space",
+			input:          "Fred Bloggs",
+			expectedResult: `"Fred Bloggs"`,
+		},
+		{
+			name:           "quotation mark",
+			input:          `we"d`,
+			expectedResult: `"we\"d"`,
+		},
+		{
+			name:           "backslash",
+			input:          `a\b`,
+			expectedResult: `"a\\b"`,
+		},
+		{
+			name:           "consective dot",
+			input:          "we..johnny",
+			expectedResult: `"we..johnny"`,
+		},
+		{
+			name:           "start with dot",
+			input:          ".johnny",
+			expectedResult: `".johnny"`,
+		},
+		{
+			name:           "at sign",
+			input:          "abc@def",
+			expectedResult: `"abc@def"`,
+		},
+		{
+			name:           "bracket",
+			input:          "abc(def)",
+			expectedResult: `"abc(def)"`,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			result := QuoteLocalPart(c.input)
+			if result != c.expectedResult {
+				st.Errorf("we are expecting %s, however we got :%s", c.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	cases := []struct {
+		name           string
+		local          string
+		domain         string
+		expectedResult string
+		err            error
+	}{
+		{
+			name:           "simple",
+			local:          "johnny",
+			domain:         "test.net",
+			expectedResult: "johnny@test.net",
+		},
+		{
+			name:           "quotation mark",
+			local:          `we"d`,
+			domain:         "test.net",
+			expectedResult: `"we\"d"@test.net`,
+		},
+		{
+			name:           "backslash",
+			local:          `we\d`,
+			domain:         "test.net",
+			expectedResult: `"we\\d"@test.net`,
+		},
+		{
+			name:           "space",
+			local:          "Fred Bloggs",
+			domain:         "example.com",
+			expectedResult: `"Fred Bloggs"@example.com`,
+		},
+		{
+			name:   "empty local part",
+			local:  "",
+			domain: "example.com",
+			err:    fmt.Errorf("empty local part"),
+		},
+		{
+			name:   "control character",
+			local:  "a\nb",
+			domain: "example.com",
+			err:    fmt.Errorf(`'\n' can't be represented in the local part of an email address`),
+		},
+		{
+			name:   "invalid domain",
+			local:  "johnny",
+			domain: `ex"ample.com`,
+			err:    fmt.Errorf(`ex"ample.com is not a valid domain`),
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			e, err := New(c.local, c.domain)
+			if nil != err && c.err == nil {
+				st.Errorf("we are not expecting error , however we got:%s", err)
+				st.FailNow()
+			}
+			if nil == err && c.err != nil {
+				st.Errorf("we are expecting err:%s, however we got nil", c.err)
+				st.FailNow()
+			}
+			if c.err != nil {
+				if c.err.Error() != err.Error() {
+					st.Errorf("we are expecting err:%s,however we got :%s", c.err, err)
+				}
+				return
+			}
+			if e.String() != c.expectedResult {
+				st.Errorf("we are expecting %s, however we got :%s", c.expectedResult, e)
+			}
+			if e.UnquotedLocalPart() != c.local {
+				st.Errorf("we are expecting %s, however we got :%s", c.local, e.UnquotedLocalPart())
+			}
+		})
+	}
+}
+
+func TestUnquotedLocalPart(t *testing.T) {
+	cases := map[string]string{
+		"a+b+@x.com":         "a+b+",
+		"a++@x.com":          "a++",
+		"a+@x.com":           "a+",
+		`"a"+"b c"+@x.com`:   "a+b c+",
+		"(comment)a+b@x.com": "a+b",
+	}
+	for input, expected := range cases {
+		e, err := Parse(input)
+		if nil != err {
+			t.Errorf("we are not expecting error , however we got:%s", err)
+			continue
+		}
+		if result := e.UnquotedLocalPart(); result != expected {
+			t.Errorf("%s: we are expecting %s, however we got :%s", input, expected, result)
+		}
+	}
+}
+
+// TestRoundTrip make sure every valid address in TestValidate survive unquote and quote
+func TestRoundTrip(t *testing.T) {
+	for _, item := range validateCases {
+		if !item.expectedResult {
+			continue
+		}
+		t.Run(item.name, func(st *testing.T) {
+			e, err := Parse(item.input)
+			if nil != err {
+				st.Errorf("we are not expecting error , however we got:%s", err)
+				st.FailNow()
+			}
+			n, err := New(e.UnquotedLocalPart(), e.Domain())
+			if nil != err {
+				st.Errorf("we are not expecting error , however we got:%s", err)
+				st.FailNow()
+			}
+			if n.Key() != e.Key() {
+				st.Errorf("we are expecting %s, however we got :%s", e.Key(), n.Key())
+			}
+			if n.UnquotedLocalPart() != e.UnquotedLocalPart() {
+				st.Errorf("we are expecting %s, however we got :%s", e.UnquotedLocalPart(), n.UnquotedLocalPart())
+			}
+		})
+	}
+}
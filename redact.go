@@ -0,0 +1,122 @@
+package emailaddress
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"strings"
+)
+
+// redactMask replace the hidden characters, it has a fixed length so the length of the address doesn't leak
+const redactMask = "***"
+
+// Redaction decide how an address is masked by Redact. Tags and comments are always dropped, as they may identify the user
+type Redaction struct {
+	// Name of the redaction
+	Name string
+	// KeepFirst and KeepLast are the number of characters kept at the start and the end of the local part,
+	// fewer characters are kept when the local part is too short to hide anything otherwise
+	KeepFirst int
+	KeepLast  int
+	// HashLocal replace the local part with a short hash of it, so the same mailbox can be correlated across the logs.
+	// The hash is an HMAC-SHA256 when HashKey is given, and a plain SHA-256 otherwise
+	HashLocal bool
+	HashKey   []byte
+	// MaskDomain mask every label of the domain but the top level one the same way as the local part,
+	// the IP address of an address literal is masked entirely
+	MaskDomain bool
+}
+
+var (
+	// RedactMasked mask both the local part and the domain, such as j***@e***.com
+	RedactMasked = Redaction{
+		Name:       "masked",
+		KeepFirst:  1,
+		MaskDomain: true,
+	}
+	// RedactKeepDomain mask only the local part, such as j***@example.com
+	RedactKeepDomain = Redaction{
+		Name:      "keep-domain",
+		KeepFirst: 1,
+	}
+	// RedactHash replace the local part with a hash, such as 96d9632f363564cc@example.com
+	RedactHash = Redaction{
+		Name:      "hash",
+		HashLocal: true,
+	}
+)
+
+// LogRedaction is the redaction LogValue apply when an address is logged with log/slog
+var LogRedaction = RedactMasked
+
+// Redact return the address masked by r, the result is meant for logs and is not an email address
+func (e *Address) Redact(r Redaction) string {
+	local := e.lp.semantic(false)
+	if r.HashLocal {
+		local = hashLocal(strings.ToLower(local), r.HashKey)
+	} else {
+		local = mask(local, r.KeepFirst, r.KeepLast)
+	}
+	domain := e.canonicalDomain()
+	if r.MaskDomain && e.dom.IsIP() {
+		// an IP address has no top level domain to keep
+		domain = "[" + redactMask + "]"
+	} else if r.MaskDomain {
+		labels := strings.Split(domain, ".")
+		for i := 0; i < len(labels)-1; i++ {
+			labels[i] = mask(labels[i], r.KeepFirst, r.KeepLast)
+		}
+		domain = strings.Join(labels, ".")
+	}
+	return local + "@" + domain
+}
+
+// Redacted return the address masked by RedactMasked
+func (e *Address) Redacted() string {
+	return e.Redact(RedactMasked)
+}
+
+// LogValue implements slog.LogValuer, the address is logged masked by LogRedaction, and a zero Address is logged as an empty string.
+// It has a value receiver, so both Address and *Address are redacted when they are logged as attributes,
+// however slog reports a nil *Address as a panic of LogValue, check it before logging.
+// String, MarshalText and MarshalJSON are not redacted, thus a struct with an Address field is logged in full
+// when the struct itself is the attribute, as slog formats it with fmt or encoding/json,
+// log the field as an attribute, or implement slog.LogValuer on the struct instead
+func (e Address) LogValue() slog.Value {
+	if nil == e.lp {
+		return slog.StringValue("")
+	}
+	return slog.StringValue(e.Redact(LogRedaction))
+}
+
+// mask keep the first and last characters of s, and replace the others with redactMask.
+// At least half of the characters are hidden
+func mask(s string, first int, last int) string {
+	runes := []rune(s)
+	if first < 0 {
+		first = 0
+	}
+	if last < 0 {
+		last = 0
+	}
+	for first+last > len(runes)/2 {
+		if last > 0 {
+			last--
+		} else {
+			first--
+		}
+	}
+	return string(runes[:first]) + redactMask + string(runes[len(runes)-last:])
+}
+
+// hashLocal return the first 8 bytes of the hash of s in hex
+func hashLocal(s string, key []byte) string {
+	if len(key) > 0 {
+		h := hmac.New(sha256.New, key)
+		h.Write([]byte(s))
+		return hex.EncodeToString(h.Sum(nil)[:8])
+	}
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:8])
+}
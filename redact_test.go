@@ -0,0 +1,140 @@
+package emailaddress
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestRedact(t *testing.T) {
+	cases := []struct {
+		name      string
+		input     string
+		redaction Redaction
+		expected  string
+	}{
+		{
+			name:      "masked",
+			input:     "john.smith@example.com",
+			redaction: RedactMasked,
+			expected:  "j***@e***.com",
+		},
+		{
+			name:      "masked sub domain",
+			input:     "john@mail.Example.co.uk",
+			redaction: RedactMasked,
+			expected:  "j***@m***.e***.c***.uk",
+		},
+		{
+			name:      "address literal",
+			input:     "john@[192.0.2.1]",
+			redaction: RedactMasked,
+			expected:  "j***@[***]",
+		},
+		{
+			name:      "ipv6 address literal",
+			input:     "john@[IPv6:2001:db8::1]",
+			redaction: RedactMasked,
+			expected:  "j***@[***]",
+		},
+		{
+			name:      "keep domain",
+			input:     "john.smith+newsletter(comment)@Example.com",
+			redaction: RedactKeepDomain,
+			expected:  "j***@example.com",
+		},
+		{
+			name:      "keep first and last",
+			input:     "john.smith@example.com",
+			redaction: Redaction{KeepFirst: 2, KeepLast: 2},
+			expected:  "jo***th@example.com",
+		},
+		{
+			name:      "short local part",
+			input:     "jo@example.com",
+			redaction: Redaction{KeepFirst: 2, KeepLast: 2},
+			expected:  "j***@example.com",
+		},
+		{
+			name:      "single character",
+			input:     "j@example.com",
+			redaction: RedactMasked,
+			expected:  "***@e***.com",
+		},
+		{
+			name:      "quoted",
+			input:     `"john smith"@example.com`,
+			redaction: RedactKeepDomain,
+			expected:  "j***@example.com",
+		},
+		{
+			name:      "unicode",
+			input:     "jörg@bücher.example",
+			redaction: RedactKeepDomain,
+			expected:  "j***@xn--bcher-kva.example",
+		},
+		{
+			name:      "hash",
+			input:     "John+tag@example.com",
+			redaction: RedactHash,
+			expected:  "96d9632f363564cc@example.com",
+		},
+		{
+			name:      "hash with key",
+			input:     "john@example.com",
+			redaction: Redaction{HashLocal: true, HashKey: []byte("secret"), MaskDomain: true},
+			expected:  "337e3f715bf0aaed@***.com",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			e, err := Parse(c.input)
+			if nil != err {
+				st.Errorf("we are not expecting error , however we got:%s", err)
+				st.FailNow()
+			}
+			if result := e.Redact(c.redaction); result != c.expected {
+				st.Errorf("we are expecting %s , however we got : %s", c.expected, result)
+			}
+		})
+	}
+}
+
+func TestLogValue(t *testing.T) {
+	e, err := Parse("john.smith@example.com")
+	if nil != err {
+		t.Fatalf("we are not expecting error , however we got:%s", err)
+	}
+	type user struct {
+		Email Address
+	}
+	u := user{Email: *e}
+	handlers := map[string]func(w *bytes.Buffer) slog.Handler{
+		"text": func(w *bytes.Buffer) slog.Handler { return slog.NewTextHandler(w, nil) },
+		"json": func(w *bytes.Buffer) slog.Handler { return slog.NewJSONHandler(w, nil) },
+	}
+	for name, newHandler := range handlers {
+		cases := map[string]interface{}{
+			"pointer":      e,
+			"value":        *e,
+			"struct field": u.Email,
+		}
+		for kind, v := range cases {
+			buf := &bytes.Buffer{}
+			slog.New(newHandler(buf)).Info("sent", "to", v)
+			if strings.Contains(buf.String(), "john.smith") || !strings.Contains(buf.String(), "j***@e***.com") {
+				t.Errorf("%s %s: we are expecting the address to be redacted, however we got : %s", name, kind, buf.String())
+			}
+		}
+	}
+	if v := (Address{}).LogValue(); v.String() != "" {
+		t.Errorf("we are expecting an empty string for a zero Address, however we got : %s", v)
+	}
+	var nilAddress *Address
+	buf := &bytes.Buffer{}
+	slog.New(slog.NewTextHandler(buf, nil)).Info("sent", "to", nilAddress)
+	if !strings.Contains(buf.String(), "to=") {
+		t.Errorf("we are expecting a nil address to be logged, however we got : %s", buf.String())
+	}
+}
@@ -0,0 +1,118 @@
+package emailaddress
+
+import (
+	"fmt"
+	"strings"
+)
+
+// canonicalDomain return the domain in lowercase punycode form without the trailing dot,
+// an address literal is in the canonical form of its IP address
+func (e *Address) canonicalDomain() string {
+	return e.dom.ASCII()
+}
+
+// quotedLocalPart return the local part with tags in minimal quoting, comments are dropped.
+// The tags are split from their text as it was written, so empty tags and a trailing '+' are kept.
+// The local part is quoted when a '+' in it would start a tag, which a '+' after its first character does,
+// unless it is a lone trailing '+' without tags. Otherwise the local part and every tag are quoted only when they are required
+func (e *Address) quotedLocalPart() string {
+	local := unquote(e.lp.localPartEmail)
+	var tags []string
+	if text := e.lp.tagsText(); len(text) > 0 {
+		for _, t := range strings.Split(text[1:], "+") {
+			tags = append(tags, unquote(t))
+		}
+	}
+	plus := -1
+	if len(local) > 1 {
+		plus = strings.IndexByte(local[1:], '+') + 1
+	}
+	startTags := plus > 0 && (len(tags) > 0 || plus != len(local)-1)
+	if plain := strings.Join(append([]string{local}, tags...), "+"); !startTags && len(local) > 0 && isDotAtom(plain) {
+		return plain
+	}
+	b := strings.Builder{}
+	if startTags || !isDotAtom(local) {
+		b.WriteString(quote(local))
+	} else {
+		b.WriteString(local)
+	}
+	for _, t := range tags {
+		b.WriteByte('+')
+		// a single empty tag is quoted, as a lone trailing '+' would be part of the local part
+		if len(t) > 0 && !isDotAtom(t) || len(tags) == 1 && len(t) == 0 {
+			b.WriteString(quote(t))
+		} else {
+			b.WriteString(t)
+		}
+	}
+	return b.String()
+}
+
+// Raw return the email address exactly as it was given to Parse
+func (e *Address) Raw() string {
+	return e.raw
+}
+
+// Canonical return the email address without comments, the local part is quoted only when it is required,
+// and the domain is in lowercase punycode form without the trailing dot.
+// The case of the local part is preserved, as RFC 5321 says it is case-sensitive.
+// It is the form suitable for storage
+func (e *Address) Canonical() string {
+	return e.quotedLocalPart() + "@" + e.canonicalDomain()
+}
+
+// Display return the email address without comments, the local part is quoted only when it is required,
+// and the domain is in the canonical form of Canonical converted to Unicode, thus johnny@XN--BCHER-KVA.example. is displayed as johnny@bücher.example.
+// An address literal is displayed in the canonical form of its IP address
+func (e *Address) Display() string {
+	domain, err := toUnicode(e.dom.ASCII())
+	if nil != err {
+		domain = e.dom.ASCII()
+	}
+	return e.quotedLocalPart() + "@" + domain
+}
+
+// ASCII return the email address without comments, the local part is quoted only when it is required,
+// and the domain is in lowercase punycode form without the trailing dot, thus johnny@Bücher.example. become johnny@xn--bcher-kva.example.
+// The domain is normalized as Canonical does, it is the form for the systems which don't support SMTPUTF8 domains.
+// Only the domain is converted, a non-ASCII local part stays as is
+func (e *Address) ASCII() string {
+	return e.quotedLocalPart() + "@" + e.dom.ASCII()
+}
+
+// Form is a way to render an address
+type Form string
+
+const (
+	// FormCanonical is the form of Canonical
+	FormCanonical Form = "canonical"
+	// FormASCII is the form of ASCII
+	FormASCII Form = "ascii"
+	// FormDisplay is the form of Display
+	FormDisplay Form = "display"
+	// FormKey is the form of Key
+	FormKey Form = "key"
+	// FormRaw is the form of Raw
+	FormRaw Form = "raw"
+)
+
+// Forms are all the forms Render support
+var Forms = []Form{FormCanonical, FormASCII, FormDisplay, FormKey, FormRaw}
+
+// Render return the address in the given form, an error is returned when the form is unknown
+func (e *Address) Render(form Form) (string, error) {
+	switch form {
+	case FormCanonical:
+		return e.Canonical(), nil
+	case FormASCII:
+		return e.ASCII(), nil
+	case FormDisplay:
+		return e.Display(), nil
+	case FormKey:
+		return e.Key(), nil
+	case FormRaw:
+		return e.Raw(), nil
+	}
+	return "", fmt.Errorf("unknown form %s", form)
+}
@@ -0,0 +1,190 @@
+package emailaddress
+
+import "testing"
+
+func TestRender(t *testing.T) {
+	cases := []struct {
+		name      string
+		input     string
+		canonical string
+		display   string
+		ascii     string
+	}{
+		{
+			name:      "simple",
+			input:     "test@test.net",
+			canonical: "test@test.net",
+			display:   "test@test.net",
+			ascii:     "test@test.net",
+		},
+		{
+			name:      "comment and mixcase",
+			input:     "(comment)Test+Tag@Test.NET",
+			canonical: "Test+Tag@test.net",
+			display:   "Test+Tag@test.net",
+			ascii:     "Test+Tag@test.net",
+		},
+		{
+			name:      "unnecessary quotation",
+			input:     `"john"@example.com`,
+			canonical: "john@example.com",
+			display:   "john@example.com",
+			ascii:     "john@example.com",
+		},
+		{
+			name:      "necessary quotation",
+			input:     `"Fred Bloggs"(comment)@example.com`,
+			canonical: `"Fred Bloggs"@example.com`,
+			display:   `"Fred Bloggs"@example.com`,
+			ascii:     `"Fred Bloggs"@example.com`,
+		},
+		{
+			name:      "escape to quotation",
+			input:     `Abc\@def@example.com`,
+			canonical: `"Abc@def"@example.com`,
+			display:   `"Abc@def"@example.com`,
+			ascii:     `"Abc@def"@example.com`,
+		},
+		{
+			name:      "mixcase domain with trailing dot",
+			input:     "J@Example.COM.",
+			canonical: "J@example.com",
+			display:   "J@example.com",
+			ascii:     "J@example.com",
+		},
+		{
+			name:      "ipv6 address literal",
+			input:     "j@[IPv6:2001:DB8::1]",
+			canonical: "j@[IPv6:2001:db8::1]",
+			display:   "j@[IPv6:2001:db8::1]",
+			ascii:     "j@[IPv6:2001:db8::1]",
+		},
+		{
+			name:      "leading plus",
+			input:     "+a@x.com",
+			canonical: "+a@x.com",
+			display:   "+a@x.com",
+			ascii:     "+a@x.com",
+		},
+		{
+			name:      "lone trailing plus",
+			input:     "a+@x.com",
+			canonical: "a+@x.com",
+			display:   "a+@x.com",
+			ascii:     "a+@x.com",
+		},
+		{
+			name:      "quoted plus",
+			input:     `"a+b"@x.com`,
+			canonical: `"a+b"@x.com`,
+			display:   `"a+b"@x.com`,
+			ascii:     `"a+b"@x.com`,
+		},
+		{
+			name:      "quoted plus with tag",
+			input:     `"a+"+b@x.com`,
+			canonical: `"a+"+b@x.com`,
+			display:   `"a+"+b@x.com`,
+			ascii:     `"a+"+b@x.com`,
+		},
+		{
+			name:      "trailing plus",
+			input:     "a+b+@x.com",
+			canonical: "a+b+@x.com",
+			display:   "a+b+@x.com",
+			ascii:     "a+b+@x.com",
+		},
+		{
+			name:      "empty tag",
+			input:     `a++"c d"@x.com`,
+			canonical: `a++"c d"@x.com`,
+			display:   `a++"c d"@x.com`,
+			ascii:     `a++"c d"@x.com`,
+		},
+		{
+			name:      "unicode domain",
+			input:     "johnny@Bücher.example.",
+			canonical: "johnny@xn--bcher-kva.example",
+			display:   "johnny@bücher.example",
+			ascii:     "johnny@xn--bcher-kva.example",
+		},
+		{
+			name:      "uppercase unicode domain",
+			input:     "a@BÜCHER.example",
+			canonical: "a@xn--bcher-kva.example",
+			display:   "a@bücher.example",
+			ascii:     "a@xn--bcher-kva.example",
+		},
+		{
+			name:      "uppercase punycode domain",
+			input:     "a@XN--BCHER-KVA.example",
+			canonical: "a@xn--bcher-kva.example",
+			display:   "a@bücher.example",
+			ascii:     "a@xn--bcher-kva.example",
+		},
+		{
+			name:      "punycode domain",
+			input:     "johnny@xn--bcher-kva.example",
+			canonical: "johnny@xn--bcher-kva.example",
+			display:   "johnny@bücher.example",
+			ascii:     "johnny@xn--bcher-kva.example",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			e, err := Parse(c.input)
+			if nil != err {
+				st.Errorf("we are not expecting error , however we got:%s", err)
+				st.FailNow()
+			}
+			if e.Raw() != c.input {
+				st.Errorf("we are expecting raw %s, however we got :%s", c.input, e.Raw())
+			}
+			if e.Canonical() != c.canonical {
+				st.Errorf("we are expecting canonical %s, however we got :%s", c.canonical, e.Canonical())
+			}
+			if e.Display() != c.display {
+				st.Errorf("we are expecting display %s, however we got :%s", c.display, e.Display())
+			}
+			if e.ASCII() != c.ascii {
+				st.Errorf("we are expecting ascii %s, however we got :%s", c.ascii, e.ASCII())
+			}
+		})
+	}
+}
+
+func TestUnicodeDomain(t *testing.T) {
+	if !Equals("johnny@bücher.example", "johnny@xn--bcher-kva.example") {
+		t.Errorf("we are expecting unicode domain to equal its punycode form")
+	}
+	if _, err := Parse("johnny@bü cher.example"); nil == err {
+		t.Errorf("we are expecting err, however we got nil")
+	}
+}
+
+func TestRenderForm(t *testing.T) {
+	e, err := Parse(`(comment)"John"+Tag@Bücher.example`)
+	if nil != err {
+		t.Errorf("we are not expecting error , however we got:%s", err)
+		t.FailNow()
+	}
+	expected := map[Form]string{
+		FormCanonical: "John+Tag@xn--bcher-kva.example",
+		FormASCII:     "John+Tag@xn--bcher-kva.example",
+		FormDisplay:   "John+Tag@bücher.example",
+		FormKey:       "John+Tag@xn--bcher-kva.example",
+		FormRaw:       `(comment)"John"+Tag@Bücher.example`,
+	}
+	for _, form := range Forms {
+		result, err := e.Render(form)
+		if nil != err {
+			t.Errorf("we are not expecting error , however we got:%s", err)
+		}
+		if result != expected[form] {
+			t.Errorf("%s: we are expecting %s, however we got :%s", form, expected[form], result)
+		}
+	}
+	if _, err := e.Render("upper"); nil == err {
+		t.Errorf("we are expecting err, however we got nil")
+	}
+}
@@ -0,0 +1,83 @@
+package emailaddress
+
+import "errors"
+
+// Result is the outcome of validating an email address, it is designed to be marshaled to JSON
+type Result struct {
+	// Input is the email address as it was given
+	Input string `json:"input"`
+	// Valid is true when the input is a legitimate email address
+	Valid     bool     `json:"valid"`
+	LocalPart string   `json:"local_part,omitempty"`
+	Tags      []string `json:"tags,omitempty"`
+	Comment   string   `json:"comment,omitempty"`
+	Domain    string   `json:"domain,omitempty"`
+	Canonical string   `json:"canonical,omitempty"`
+	// Error describe why the input is invalid
+	Error string `json:"error,omitempty"`
+	// ErrorCode classify the error, see ParseError
+	ErrorCode ErrorCode `json:"error_code,omitempty"`
+	// ErrorOffset is the byte offset in the input where the error is found, it is nil when it is unknown
+	ErrorOffset *int `json:"error_offset,omitempty"`
+	// Warnings are the diagnoses of a valid address, see Address.Warnings
+	Warnings []Warning `json:"warnings,omitempty"`
+	// Checks are the results of the extra checks on a valid address, e.g. MX lookup
+	Checks []Check `json:"checks,omitempty"`
+}
+
+// Check is the result of an extra check on an email address
+type Check struct {
+	// Name of the check, e.g. mx
+	Name string `json:"name"`
+	// Passed is true when the address passed the check
+	Passed bool `json:"passed"`
+	// Detail explain the result
+	Detail string `json:"detail,omitempty"`
+}
+
+// NewResult build a Result from what Parse or ParseWithProfile return
+func NewResult(input string, e *Address, err error) Result {
+	r := Result{
+		Input: input,
+	}
+	if nil != err {
+		r.Error = err.Error()
+		var pe *ParseError
+		if errors.As(err, &pe) {
+			offset := pe.Offset
+			r.ErrorCode = pe.Code
+			r.ErrorOffset = &offset
+		}
+		return r
+	}
+	r.Valid = true
+	r.LocalPart = e.LocalPart()
+	r.Tags = e.Tags()
+	r.Comment = e.Comment()
+	r.Domain = e.Domain()
+	r.Canonical = e.Canonical()
+	r.Warnings = e.Warnings()
+	return r
+}
+
+// AddCheck append the result of a check, see Passed
+func (r *Result) AddCheck(name string, passed bool, detail string) {
+	r.Checks = append(r.Checks, Check{
+		Name:   name,
+		Passed: passed,
+		Detail: detail,
+	})
+}
+
+// Passed is true when the address is valid and passed all the checks
+func (r Result) Passed() bool {
+	if !r.Valid {
+		return false
+	}
+	for _, c := range r.Checks {
+		if !c.Passed {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,56 @@
+package emailaddress
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNewResult(t *testing.T) {
+	cases := []struct {
+		name           string
+		input          string
+		expectedResult string
+	}{
+		{
+			name:           "valid",
+			input:          "(comment)John+a+b@Test.net",
+			expectedResult: `{"input":"(comment)John+a+b@Test.net","valid":true,"local_part":"John","tags":["a","b"],"comment":"comment","domain":"Test.net","canonical":"John+a+b@test.net","warnings":[{"code":"cfws_comment","offset":0,"message":"comment is not part of the mailbox"}]}`,
+		},
+		{
+			name:           "invalid",
+			input:          "test@",
+			expectedResult: `{"input":"test@","valid":false,"error":"domain part can't be empty","error_code":"empty_domain","error_offset":5}`,
+		},
+		{
+			name:           "invalid at the begining",
+			input:          "@test.net",
+			expectedResult: `{"input":"@test.net","valid":false,"error":"email address can't start with '@'","error_code":"empty_local_part","error_offset":0}`,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			e, err := Parse(c.input)
+			r := NewResult(c.input, e, err)
+			buf, err := json.Marshal(r)
+			if nil != err {
+				st.Errorf("we are not expecting error , however we got:%s", err)
+				st.FailNow()
+			}
+			if string(buf) != c.expectedResult {
+				st.Errorf("we are expecting %s, however we got :%s", c.expectedResult, buf)
+			}
+		})
+	}
+}
+
+func TestResultChecks(t *testing.T) {
+	e, err := Parse("test@test.net")
+	r := NewResult("test@test.net", e, err)
+	if !r.Passed() {
+		t.Errorf("we are expecting the result to pass")
+	}
+	r.AddCheck("mx", false, "test.net has no MX record")
+	if r.Passed() || !r.Valid {
+		t.Errorf("we are expecting the result to be valid but not passed")
+	}
+}
@@ -0,0 +1,153 @@
+package emailaddress
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ParseBytes parse the given email address as Parse does. It is a convenience wrapper that copies b into a string,
+// so b can be reused after, use ValidBytes to check an address without the copy
+func ParseBytes(b []byte) (*Address, error) {
+	return parseEmailAddress(string(b))
+}
+
+// ScanMode decide what a Scanner yields
+type ScanMode int
+
+const (
+	// ScanLines yield one address per line
+	ScanLines ScanMode = iota
+	// ScanList yield every element of the comma separated address list on each line, as ParseList does
+	ScanList
+)
+
+// ScanError is the error of an address the Scanner fail to parse, with its position in the input
+type ScanError struct {
+	// Line is the line number, starting from 1
+	Line int
+	// Element is the index of the element in the address list of the line starting from 1, it is 0 in ScanLines mode
+	Element int
+	// Text is the address as it is in the input
+	Text string
+	Err  error
+}
+
+// Error implements error interface
+func (e *ScanError) Error() string {
+	if e.Element > 0 {
+		return fmt.Sprintf("line %d element %d: %s", e.Line, e.Element, e.Err)
+	}
+	return fmt.Sprintf("line %d: %s", e.Line, e.Err)
+}
+
+// Unwrap return the parse error
+func (e *ScanError) Unwrap() error {
+	return e.Err
+}
+
+// Scanner read email addresses from an io.Reader, the white spaces around every address are trimmed,
+// and the empty lines and elements are skipped. Like bufio.Scanner, it is used as
+//
+//	s := emailaddress.NewScanner(r, emailaddress.ScanLines)
+//	for s.Scan() {
+//		e, err := s.Address()
+//		...
+//	}
+//	if err := s.Err(); nil != err {
+//		...
+//	}
+type Scanner struct {
+	scanner *bufio.Scanner
+	mode    ScanMode
+	line    int
+	// elements are the elements of the current line not yet scanned, and next is the index of the first of them
+	elements []string
+	next     int
+	text     string
+	element  int
+	address  *Address
+	err      error
+}
+
+// NewScanner return a Scanner reading from r
+func NewScanner(r io.Reader, mode ScanMode) *Scanner {
+	return &Scanner{
+		scanner: bufio.NewScanner(r),
+		mode:    mode,
+	}
+}
+
+// Buffer set the initial buffer and the maximum length of a line, as bufio.Scanner.Buffer
+func (s *Scanner) Buffer(buf []byte, max int) {
+	s.scanner.Buffer(buf, max)
+}
+
+// Scan advance to the next address, which is then available through Address.
+// It return false when the input is exhausted or there is a read error, see Err
+func (s *Scanner) Scan() bool {
+	for {
+		for s.next < len(s.elements) {
+			text := s.elements[s.next]
+			s.next++
+			if len(text) == 0 {
+				continue
+			}
+			s.text = text
+			s.element = 0
+			if s.mode == ScanList {
+				s.element = s.next
+			}
+			s.address, s.err = parseListElement(text, s.mode == ScanList)
+			if nil != s.err {
+				s.err = &ScanError{Line: s.line, Element: s.element, Text: text, Err: s.err}
+			}
+			return true
+		}
+		if !s.scanner.Scan() {
+			s.text, s.element, s.address, s.err = "", 0, nil, nil
+			return false
+		}
+		s.line++
+		s.elements, s.next = s.split(s.scanner.Bytes()), 0
+	}
+}
+
+// split the line into the elements to parse, the line is converted to string only once
+func (s *Scanner) split(line []byte) []string {
+	text := strings.TrimSpace(string(line))
+	if len(text) == 0 {
+		return nil
+	}
+	if s.mode == ScanList {
+		return splitList(text)
+	}
+	return []string{text}
+}
+
+// Address return the current address, the error is a *ScanError when it is not a legitimate email address
+func (s *Scanner) Address() (*Address, error) {
+	return s.address, s.err
+}
+
+// Text return the current address as it is in the input
+func (s *Scanner) Text() string {
+	return s.text
+}
+
+// Line return the line number of the current address, starting from 1
+func (s *Scanner) Line() int {
+	return s.line
+}
+
+// Element return the index of the current address in the address list of its line starting from 1,
+// it is 0 in ScanLines mode
+func (s *Scanner) Element() int {
+	return s.element
+}
+
+// Err return the first non-EOF read error, the parse errors are returned by Address
+func (s *Scanner) Err() error {
+	return s.scanner.Err()
+}
@@ -0,0 +1,121 @@
+package emailaddress
+
+import (
+	"bufio"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseBytes(t *testing.T) {
+	b := []byte("johnny+tag@test.net")
+	e, err := ParseBytes(b)
+	if nil != err {
+		t.Fatalf("we are not expecting error , however we got:%s", err)
+	}
+	copy(b, "xxxxxx")
+	if e.Raw() != "johnny+tag@test.net" || e.Domain() != "test.net" {
+		t.Errorf("we are expecting the address not to change with the bytes, however we got : %s", e.Raw())
+	}
+	if _, err := ParseBytes(nil); !errors.Is(err, ErrEmptyEmail) {
+		t.Errorf("we are expecting ErrEmptyEmail, however we got : %v", err)
+	}
+}
+
+type scanned struct {
+	line    int
+	element int
+	text    string
+	valid   bool
+}
+
+func TestScanner(t *testing.T) {
+	cases := []struct {
+		name           string
+		input          string
+		mode           ScanMode
+		expectedResult []scanned
+	}{
+		{
+			name:  "lines",
+			input: "test@test.net\r\n\n  johnny+tag@test.net \ninvalid@@test.net",
+			mode:  ScanLines,
+			expectedResult: []scanned{
+				{line: 1, text: "test@test.net", valid: true},
+				{line: 3, text: "johnny+tag@test.net", valid: true},
+				{line: 4, text: "invalid@@test.net"},
+			},
+		},
+		{
+			name:  "lines with comma",
+			input: "a@test.net, b@test.net",
+			mode:  ScanLines,
+			expectedResult: []scanned{
+				{line: 1, text: "a@test.net, b@test.net"},
+			},
+		},
+		{
+			name:  "list",
+			input: `a@test.net, "b, c"@test.net,,` + "\n\nd@@test.net , e@test.net\n",
+			mode:  ScanList,
+			expectedResult: []scanned{
+				{line: 1, element: 1, text: "a@test.net", valid: true},
+				{line: 1, element: 2, text: `"b, c"@test.net`, valid: true},
+				{line: 3, element: 1, text: "d@@test.net"},
+				{line: 3, element: 2, text: "e@test.net", valid: true},
+			},
+		},
+		{
+			name:  "list of name-addrs",
+			input: `Ops <ops@test.net>, "B, C" <b@test.net>`,
+			mode:  ScanList,
+			expectedResult: []scanned{
+				{line: 1, element: 1, text: "Ops <ops@test.net>", valid: true},
+				{line: 1, element: 2, text: `"B, C" <b@test.net>`, valid: true},
+			},
+		},
+		{
+			name:           "empty",
+			input:          "\n \n",
+			mode:           ScanList,
+			expectedResult: nil,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			var result []scanned
+			s := NewScanner(strings.NewReader(c.input), c.mode)
+			for s.Scan() {
+				e, err := s.Address()
+				item := scanned{line: s.Line(), element: s.Element(), text: s.Text(), valid: nil == err}
+				if nil != err {
+					var se *ScanError
+					if !errors.As(err, &se) || se.Line != s.Line() || se.Element != s.Element() || se.Text != s.Text() {
+						st.Errorf("we are expecting a ScanError at line %d, however we got : %v", s.Line(), err)
+					}
+				} else if e.Raw() != s.Text() && !strings.HasSuffix(s.Text(), "<"+e.Raw()+">") {
+					st.Errorf("we are expecting %s , however we got : %s", s.Text(), e.Raw())
+				}
+				result = append(result, item)
+			}
+			if err := s.Err(); nil != err {
+				st.Errorf("we are not expecting error , however we got:%s", err)
+			}
+			if !reflect.DeepEqual(result, c.expectedResult) {
+				st.Errorf("we are expecting %+v , however we got : %+v", c.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestScannerErr(t *testing.T) {
+	s := NewScanner(strings.NewReader(strings.Repeat("a", 100)+"@test.net"), ScanLines)
+	s.Buffer(make([]byte, 16), 32)
+	if s.Scan() {
+		t.Errorf("we are not expecting Scan to succeed on a line too long")
+	}
+	if !errors.Is(s.Err(), bufio.ErrTooLong) {
+		t.Errorf("we are expecting bufio.ErrTooLong, however we got : %v", s.Err())
+	}
+}
@@ -0,0 +1,95 @@
+package emailaddress
+
+// popularDomains are the mail domains Suggest compare with
+var popularDomains = []string{
+	"gmail.com",
+	"googlemail.com",
+	"yahoo.com",
+	"yahoo.co.uk",
+	"hotmail.com",
+	"hotmail.co.uk",
+	"outlook.com",
+	"live.com",
+	"msn.com",
+	"aol.com",
+	"icloud.com",
+	"me.com",
+	"mail.com",
+	"gmx.com",
+	"gmx.de",
+	"web.de",
+	"yandex.ru",
+	"mail.ru",
+	"qq.com",
+	"163.com",
+	"protonmail.com",
+	"proton.me",
+	"comcast.net",
+	"verizon.net",
+	"att.net",
+}
+
+// Suggest return the email address with its domain corrected, when the domain looks like a typo of a popular mail domain,
+// thus johnny@gmial.com become johnny@gmail.com.
+// An empty string is returned when there is nothing to suggest,
+// and an error is returned when the given email address is not legitimate
+func Suggest(emailAddress string) (string, error) {
+	e, err := parseEmailAddress(emailAddress)
+	if nil != err {
+		return "", err
+	}
+	domain := e.canonicalDomain()
+	best := ""
+	bestDistance := 0
+	for _, candidate := range popularDomains {
+		if candidate == domain {
+			return "", nil
+		}
+		d := editDistance(domain, candidate)
+		if d > maxTypoDistance(candidate) {
+			continue
+		}
+		if len(best) == 0 || d < bestDistance {
+			best = candidate
+			bestDistance = d
+		}
+	}
+	if len(best) == 0 {
+		return "", nil
+	}
+	return e.quotedLocalPart() + "@" + best, nil
+}
+
+// maxTypoDistance is the maximum edit distance still considered a typo of domain, short domains only tolerate one edit
+func maxTypoDistance(domain string) int {
+	if len(domain) <= 7 {
+		return 1
+	}
+	return 2
+}
+
+// editDistance return the optimal string alignment distance between a and b,
+// which counts insertions, deletions, substitutions and transpositions of adjacent characters
+func editDistance(a, b string) int {
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(b)]
+}
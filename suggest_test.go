@@ -0,0 +1,84 @@
+package emailaddress
+
+import "testing"
+
+func TestSuggest(t *testing.T) {
+	cases := []struct {
+		name           string
+		input          string
+		expectedResult string
+		hasErr         bool
+	}{
+		{
+			name:           "transposition",
+			input:          "johnny@gmial.com",
+			expectedResult: "johnny@gmail.com",
+		},
+		{
+			name:           "missing character",
+			input:          "johnny@hotmal.com",
+			expectedResult: "johnny@hotmail.com",
+		},
+		{
+			name:           "wrong tld",
+			input:          "johnny@gmail.co",
+			expectedResult: "johnny@gmail.com",
+		},
+		{
+			name:           "mixcase and comment",
+			input:          `"Fred Bloggs"(comment)@YAHOO.CMO`,
+			expectedResult: `"Fred Bloggs"@yahoo.com`,
+		},
+		{
+			name:           "popular domain",
+			input:          "johnny@gmail.com",
+			expectedResult: "",
+		},
+		{
+			name:           "not close enough",
+			input:          "johnny@example.com",
+			expectedResult: "",
+		},
+		{
+			name:           "short domain",
+			input:          "johnny@me.org",
+			expectedResult: "",
+		},
+		{
+			name:   "invalid address",
+			input:  "johnny@",
+			hasErr: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			result, err := Suggest(c.input)
+			if c.hasErr != (nil != err) {
+				st.Errorf("we are expecting err: %t, however we got :%v", c.hasErr, err)
+				st.FailNow()
+			}
+			if result != c.expectedResult {
+				st.Errorf("we are expecting %s, however we got :%s", c.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"gmail.com", "gmail.com", 0},
+		{"gmial.com", "gmail.com", 1},
+		{"gmai.com", "gmail.com", 1},
+		{"kitten", "sitting", 3},
+	}
+	for _, c := range cases {
+		if d := editDistance(c.a, c.b); d != c.expected {
+			t.Errorf("distance between %s and %s, we are expecting %d, however we got %d", c.a, c.b, c.expected, d)
+		}
+	}
+}
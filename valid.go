@@ -0,0 +1,218 @@
+package emailaddress
+
+import "strings"
+
+const (
+	// classLocalSingle are the characters a local part of a single character can be, see validLocalPartChars
+	classLocalSingle uint8 = 1 << iota
+	// classSpecial are the characters only valid in a quoted string, a comment or escaped, see specialLocalCharacters
+	classSpecial
+	// classControl are the ASCII control characters
+	classControl
+)
+
+// charClass classify every byte, so the hot loops look up a table instead of searching strings
+var charClass = func() [256]uint8 {
+	var table [256]uint8
+	for i := 0; i < len(validLocalPartChars); i++ {
+		table[validLocalPartChars[i]] |= classLocalSingle
+	}
+	for i := 0; i < len(specialLocalCharacters); i++ {
+		table[specialLocalCharacters[i]] |= classSpecial
+	}
+	for c := 0; c < ' '; c++ {
+		table[c] |= classControl
+	}
+	table[0x7f] |= classControl
+	return table
+}()
+
+// isControl checks whether c is an ASCII control character
+func isControl(c byte) bool {
+	return charClass[c]&classControl != 0
+}
+
+// Valid report whether emailAddress is a legitimate email address, it accepts exactly what Validate accepts.
+// Unlike Validate it doesn't build the address or an error, and it does no heap allocation
+// unless the domain is an internationalized domain name, which has to be converted to punycode, or an address literal
+func Valid(emailAddress string) bool {
+	return valid(emailAddress)
+}
+
+// ValidBytes report whether b is a legitimate email address as Valid does, b is not copied,
+// so checking a line read from a buffer doesn't allocate either
+func ValidBytes(b []byte) bool {
+	return valid(b)
+}
+
+// valid is the implementation of Valid and ValidBytes
+func valid[T string | []byte](emailAddress T) bool {
+	atLoc := -1
+	inQuotation := false
+	var previousChar byte
+	escape := 0
+	for i := 0; i < len(emailAddress); i++ {
+		c := emailAddress[i]
+		switch c {
+		case '"':
+			if previousChar != byteEscape {
+				inQuotation = !inQuotation
+			}
+		case '@':
+			if !inQuotation && previousChar != byteEscape {
+				if atLoc > -1 {
+					return false
+				}
+				atLoc = i
+				continue
+			}
+		}
+		if c == byteEscape {
+			escape++
+		} else {
+			escape = 0
+		}
+		if escape > 0 && escape%2 == 0 {
+			previousChar = 0
+		} else {
+			previousChar = c
+		}
+	}
+	lenDomain := len(emailAddress) - atLoc - 1
+	if atLoc < 1 || lenDomain == 0 || lenDomain > MaxDomainLength {
+		return false
+	}
+	localLength, ok := validLocalPart(emailAddress[:atLoc])
+	if !ok || localLength > DefaultLimits.LocalPart {
+		return false
+	}
+	domain := emailAddress[atLoc+1:]
+	if domain[0] == '[' {
+		literal, err := parseAddressLiteral(string(domain), atLoc+1)
+		return nil == err && localLength+1+len(literal.ASCII()) <= DefaultLimits.Address
+	}
+	if nonASCIIOffset(domain) > -1 {
+		ascii, err := toASCII(string(domain))
+		if nil != err || !IsDomainName(ascii) {
+			return false
+		}
+		return localLength+1+len(strings.TrimSuffix(ascii, ".")) <= DefaultLimits.Address
+	}
+	if !isDomainName(domain) {
+		return false
+	}
+	if domain[len(domain)-1] == '.' {
+		domain = domain[:len(domain)-1]
+	}
+	return localLength+1+len(domain) <= DefaultLimits.Address
+}
+
+// validLocalPart report whether parseLocalPart accepts lp, and the length of its unquoted form without comments
+func validLocalPart[T string | []byte](lp T) (int, bool) {
+	localPartLength := len(lp)
+	if localPartLength == 0 {
+		return 0, false
+	}
+	if localPartLength == 1 {
+		return 1, charClass[lp[0]]&classLocalSingle != 0
+	}
+	inQuotation := false
+	var previousChar byte
+	escape := 0
+	commentStart := -1
+	commentEnd := -1
+	for idx := 0; idx < localPartLength; idx++ {
+		c := lp[idx]
+		inComment := commentStart > -1 && commentEnd == -1
+		switch {
+		case c == '"':
+			if previousChar != byteEscape {
+				inQuotation = !inQuotation
+			}
+		case c == '+':
+		case c == '.':
+			nextToComment := !inQuotation && !inComment && (commentStart == 0 && commentEnd == idx-1 || idx+1 < localPartLength && lp[idx+1] == '(')
+			if idx == 0 || idx == localPartLength-1 || nextToComment {
+				return 0, false
+			}
+			if previousChar == '.' && !inQuotation {
+				return 0, false
+			}
+		case c == byteEscape:
+			escape++
+		case charClass[c]&classSpecial != 0:
+			if !inQuotation && !inComment && previousChar != byteEscape {
+				return 0, false
+			}
+		case c == '(':
+			if !inQuotation && previousChar != byteEscape {
+				if commentStart > -1 {
+					return 0, false
+				}
+				commentStart = idx
+			}
+		case c == ')':
+			if !inQuotation && previousChar != byteEscape {
+				if !inComment && commentStart > -1 {
+					return 0, false
+				}
+				commentEnd = idx
+			}
+		default:
+			if isControl(c) && !(c == '\t' && inQuotation) {
+				return 0, false
+			}
+			if previousChar == byteEscape && !inQuotation {
+				return 0, false
+			}
+		}
+		if c != byteEscape {
+			escape = 0
+		}
+		if escape > 0 && escape%2 == 0 {
+			previousChar = 0
+		} else {
+			previousChar = c
+		}
+	}
+	if inQuotation {
+		return 0, false
+	}
+	if (commentStart > -1) != (commentEnd > -1) {
+		return 0, false
+	}
+	if commentStart > 0 && commentEnd != localPartLength-1 {
+		return 0, false
+	}
+	if commentStart == 0 && commentEnd == localPartLength-1 {
+		return 0, false
+	}
+	start, end := 0, localPartLength
+	if commentStart == 0 {
+		start = commentEnd + 1
+	}
+	if commentStart > 0 {
+		end = commentStart
+	}
+	return unquotedLength(lp[start:end]), true
+}
+
+// unquotedLength return the length of unquote(s) without building it
+func unquotedLength[T string | []byte](s T) int {
+	length := 0
+	escaped := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case escaped:
+			length++
+			escaped = false
+		case c == byteEscape:
+			escaped = true
+		case c == '"':
+		default:
+			length++
+		}
+	}
+	return length
+}
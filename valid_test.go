@@ -0,0 +1,119 @@
+package emailaddress
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValid(t *testing.T) {
+	inputs := []string{
+		";@test.net",
+		".@test.net",
+		"a@test.net",
+		`\@test.net`,
+		`a\\@test.net`,
+		`a\\\@b@test.net`,
+		`"a\"b"@test.net`,
+		`"a"b"@test.net`,
+		"(comment)a@test.net",
+		"a(comment@test.net",
+		"a)comment(@test.net",
+		"a(x)b(y)@test.net",
+		"a..b@test.net",
+		`"a..b"@test.net`,
+		"a.@test.net",
+		"a@b@test.net",
+		"@test.net",
+		"a@",
+		"a@-test.net",
+		"a@bücher.example",
+		"a@bü--cher.example",
+		strings.Repeat("a", MaxLocalPart) + "@test.net",
+		strings.Repeat("a", MaxLocalPart+1) + "@test.net",
+		"a@" + strings.Repeat("b.", 128),
+		`"` + strings.Repeat("a", MaxLocalPart) + `"@test.net`,
+		"a@" + strings.Repeat(strings.Repeat("b", 63)+".", 3) + strings.Repeat("c", 60),
+		"ab@" + strings.Repeat(strings.Repeat("b", 63)+".", 3) + strings.Repeat("c", 60),
+		"a@" + strings.Repeat("b", 64) + ".net",
+		"a@[192.0.2.1]",
+		"a@[IPv6:2001:db8::1]",
+		"a@[2001:db8::1]",
+		"a@[192.0.2.1",
+		"a@[]",
+	}
+	for _, item := range validateCases {
+		inputs = append(inputs, item.input)
+	}
+	for _, input := range inputs {
+		expected, _ := Validate(input)
+		if result := Valid(input); result != expected {
+			t.Errorf("%q: Validate return %t, however Valid return %t", input, expected, result)
+		}
+		if result := ValidBytes([]byte(input)); result != expected {
+			t.Errorf("%q: Validate return %t, however ValidBytes return %t", input, expected, result)
+		}
+	}
+}
+
+func TestValidAllocs(t *testing.T) {
+	inputs := []string{
+		"test@test.net",
+		"(hello)test+tag@test.net",
+		`"we\"d"@test.net`,
+		"invalid@@test.net",
+		"invalid@-test.net",
+	}
+	for _, input := range inputs {
+		if allocs := testing.AllocsPerRun(100, func() { Valid(input) }); allocs != 0 {
+			t.Errorf("we are expecting no allocation for %s, however we got %.0f", input, allocs)
+		}
+		b := []byte(input)
+		if allocs := testing.AllocsPerRun(100, func() { ValidBytes(b) }); allocs != 0 {
+			t.Errorf("we are expecting ValidBytes to do no allocation for %s, however we got %.0f", input, allocs)
+		}
+	}
+}
+
+func BenchmarkValid(b *testing.B) {
+	inputs := []struct {
+		name           string
+		input          string
+		expectedResult bool
+	}{
+		{
+			name:           "test@test.net",
+			input:          "test@test.net",
+			expectedResult: true,
+		},
+		{
+			name:           "With Comment At the begining",
+			input:          "(hello)test@test.net",
+			expectedResult: true,
+		},
+		{
+			name:           "With One Tag",
+			input:          "test+test1@test.net",
+			expectedResult: true,
+		},
+		{
+			name:           "email with quote",
+			input:          "\"display\"@helloworld.net",
+			expectedResult: true,
+		},
+		{
+			name:           "invalid",
+			input:          "test@@test.net",
+			expectedResult: false,
+		},
+	}
+	for _, item := range inputs {
+		b.Run(item.name, func(sb *testing.B) {
+			sb.ReportAllocs()
+			for i := 0; i < sb.N; i++ {
+				if Valid(item.input) != item.expectedResult {
+					sb.Fail()
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,290 @@
+package emailaddress
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// tagName is the struct tag ValidateStruct looks for
+const tagName = "emailaddress"
+
+// ErrNoMX indicate the domain of an email address has no MX record
+var ErrNoMX = fmt.Errorf("domain has no MX record")
+
+var addressType = reflect.TypeOf(Address{})
+
+// FieldError annotate the error of a struct field with the path of the field
+type FieldError struct {
+	// Path of the field, e.g. Contacts[0].Email or Emails["work"]
+	Path string
+	// Value of the field
+	Value string
+	// Err is the error from parsing or checking the value
+	Err error
+}
+
+// Error implements error
+func (fe *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", fe.Path, fe.Err)
+}
+
+// Unwrap return the underlying error
+func (fe *FieldError) Unwrap() error {
+	return fe.Err
+}
+
+// ValidationErrors are all the invalid fields found by ValidateStruct
+type ValidationErrors []*FieldError
+
+// Error implements error
+func (ve ValidationErrors) Error() string {
+	msgs := make([]string, 0, len(ve))
+	for _, fe := range ve {
+		msgs = append(msgs, fe.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// fieldRules are the options of a emailaddress struct tag
+type fieldRules struct {
+	required bool
+	profile  Profile
+	mx       bool
+}
+
+// parseFieldRules parse the emailaddress struct tag, e.g. `emailaddress:"required,profile=rfc5321,mx"`
+func parseFieldRules(tag string) (fieldRules, error) {
+	rules := fieldRules{
+		profile: ProfileRFC5322,
+	}
+	for _, option := range strings.Split(tag, ",") {
+		option = strings.TrimSpace(option)
+		switch {
+		case len(option) == 0:
+		case option == "required":
+			rules.required = true
+		case option == "mx":
+			rules.mx = true
+		case strings.HasPrefix(option, "profile="):
+			name := strings.TrimPrefix(option, "profile=")
+			p, ok := LookupProfile(name)
+			if !ok {
+				return rules, fmt.Errorf("unknown profile %s", name)
+			}
+			rules.profile = p
+		default:
+			return rules, fmt.Errorf("unknown option %s", option)
+		}
+	}
+	return rules, nil
+}
+
+// validate s against the rules, an empty s is only invalid when it is required
+func (r fieldRules) validate(s string) error {
+	if len(s) == 0 {
+		if r.required {
+			return ErrEmptyEmail
+		}
+		return nil
+	}
+	e, err := ParseWithProfile(s, r.profile)
+	if nil != err {
+		return err
+	}
+	if r.mx && !HasDomainMX(e.canonicalDomain()) {
+		return fmt.Errorf("%s: %w", e.canonicalDomain(), ErrNoMX)
+	}
+	return nil
+}
+
+// ValidateStruct walk through the exported fields of the given struct, including the nested structs, slices and maps,
+// and validate the fields tagged with emailaddress.
+// Tagged fields should be string, Address, pointers to them, or slices, arrays and maps of them.
+// The tag is a comma separated option list:
+//
+//	required        the field can't be empty
+//	profile=NAME    validate against the named profile, see LookupProfile, rfc5322 by default
+//	mx              the domain should have MX record
+//
+// for example
+//
+//	type User struct {
+//		Email    string            `emailaddress:"required,profile=rfc5321,mx"`
+//		Contacts map[string]string `emailaddress:""`
+//	}
+//
+// ValidationErrors is returned when any of the field is invalid,
+// and other errors are returned when the tags are malformed or v is not a struct
+func ValidateStruct(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return fmt.Errorf("ValidateStruct expects a struct, however got nil")
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("ValidateStruct expects a struct, however got %T", v)
+	}
+	w := &structWalker{
+		visited: make(map[uintptr]bool),
+	}
+	if err := w.walkStruct(rv, ""); nil != err {
+		return err
+	}
+	if len(w.errs) > 0 {
+		return w.errs
+	}
+	return nil
+}
+
+// structWalker collect the invalid fields
+type structWalker struct {
+	errs    ValidationErrors
+	visited map[uintptr]bool
+}
+
+// walkStruct visit the exported fields of rv
+func (w *structWalker) walkStruct(rv reflect.Value, path string) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		fieldPath := sf.Name
+		if len(path) > 0 {
+			fieldPath = path + "." + sf.Name
+		}
+		tag, ok := sf.Tag.Lookup(tagName)
+		if tag == "-" {
+			continue
+		}
+		if !ok {
+			if err := w.walk(rv.Field(i), fieldPath); nil != err {
+				return err
+			}
+			continue
+		}
+		rules, err := parseFieldRules(tag)
+		if nil != err {
+			return fmt.Errorf("%s: invalid %s tag: %w", fieldPath, tagName, err)
+		}
+		if err := w.check(rv.Field(i), fieldPath, rules); nil != err {
+			return err
+		}
+	}
+	return nil
+}
+
+// deref follow the pointers and interfaces, isNil is true when a nil is reached,
+// and seen is true when a pointer to struct was visited already, which prevent walking a cycle forever
+func (w *structWalker) deref(v reflect.Value) (result reflect.Value, isNil bool, seen bool) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return v, true, false
+		}
+		if v.Kind() == reflect.Ptr && v.Elem().Kind() == reflect.Struct {
+			if w.visited[v.Pointer()] {
+				return v, false, true
+			}
+			w.visited[v.Pointer()] = true
+		}
+		v = v.Elem()
+	}
+	return v, false, false
+}
+
+// walk an untagged value to find the nested tagged fields
+func (w *structWalker) walk(v reflect.Value, path string) error {
+	v, isNil, seen := w.deref(v)
+	if isNil || seen {
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		if v.Type() == addressType {
+			return nil
+		}
+		return w.walkStruct(v, path)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := w.walk(v.Index(i), fmt.Sprintf("%s[%d]", path, i)); nil != err {
+				return err
+			}
+		}
+	case reflect.Map:
+		for _, k := range sortedMapKeys(v) {
+			if err := w.walk(v.MapIndex(k), mapPath(path, k)); nil != err {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// check a tagged value against rules
+func (w *structWalker) check(v reflect.Value, path string, rules fieldRules) error {
+	v, isNil, seen := w.deref(v)
+	if seen {
+		return nil
+	}
+	if isNil {
+		w.validate("", path, rules)
+		return nil
+	}
+	switch {
+	case v.Type() == addressType:
+		a := v.Interface().(Address)
+		w.validate(a.raw, path, rules)
+	case v.Kind() == reflect.String:
+		w.validate(v.String(), path, rules)
+	case v.Kind() == reflect.Struct:
+		return w.walkStruct(v, path)
+	case v.Kind() == reflect.Slice || v.Kind() == reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := w.check(v.Index(i), fmt.Sprintf("%s[%d]", path, i), rules); nil != err {
+				return err
+			}
+		}
+	case v.Kind() == reflect.Map:
+		for _, k := range sortedMapKeys(v) {
+			if err := w.check(v.MapIndex(k), mapPath(path, k), rules); nil != err {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("%s: %s tag is not supported on %s", path, tagName, v.Type())
+	}
+	return nil
+}
+
+// validate s, and record the error
+func (w *structWalker) validate(s string, path string, rules fieldRules) {
+	if err := rules.validate(s); nil != err {
+		w.errs = append(w.errs, &FieldError{
+			Path:  path,
+			Value: s,
+			Err:   err,
+		})
+	}
+}
+
+// sortedMapKeys return the keys of the map in a stable order, so the errors are reported in a stable order
+func sortedMapKeys(v reflect.Value) []reflect.Value {
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+	})
+	return keys
+}
+
+// mapPath return the path of a map element
+func mapPath(path string, k reflect.Value) string {
+	if k.Kind() == reflect.String {
+		return fmt.Sprintf("%s[%q]", path, k.String())
+	}
+	return fmt.Sprintf("%s[%v]", path, k)
+}
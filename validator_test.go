@@ -0,0 +1,145 @@
+package emailaddress
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+)
+
+func TestValidateStruct(t *testing.T) {
+	origin := lookupMX
+	defer func() {
+		lookupMX = origin
+	}()
+	lookupMX = func(ctx context.Context, domain string) ([]*net.MX, error) {
+		if domain == "test.net" {
+			return []*net.MX{{Host: "mx.test.net", Pref: 10}}, nil
+		}
+		return nil, fmt.Errorf("no such host")
+	}
+
+	type contact struct {
+		Email string `emailaddress:"required,profile=rfc5321"`
+	}
+	type user struct {
+		Primary   string            `emailaddress:"required,mx"`
+		Secondary *string           `emailaddress:""`
+		Parsed    Address           `emailaddress:"profile=html5"`
+		Aliases   []string          `emailaddress:"profile=html5"`
+		Others    map[string]string `emailaddress:""`
+		Contacts  []contact
+		Lookup    map[string]*contact
+		Self      *user
+		Ignored   string `emailaddress:"-"`
+		unexposed string `emailaddress:"required"`
+	}
+	secondary := "test@"
+	quoted, err := Parse(`"john"@example.com`)
+	if nil != err {
+		t.Errorf("we are not expecting error , however we got:%s", err)
+		t.FailNow()
+	}
+
+	valid := &user{
+		Primary:  "test@test.net",
+		Aliases:  []string{"a@test.net", "b@test.net"},
+		Others:   map[string]string{"work": "work@test.net"},
+		Contacts: []contact{{Email: "c@test.net"}},
+		Ignored:  "not an email",
+	}
+	valid.Self = valid
+	if err := ValidateStruct(valid); nil != err {
+		t.Errorf("we are not expecting error , however we got:%s", err)
+	}
+
+	invalid := user{
+		Primary:   "test@example.com",
+		Secondary: &secondary,
+		Parsed:    *quoted,
+		Aliases:   []string{"a@test.net", "b(comment)@test.net"},
+		Others:    map[string]string{"work": "work@@test.net", "home": ""},
+		Contacts:  []contact{{Email: "c@test.net"}, {}},
+		Lookup:    map[string]*contact{"x": {Email: "x(comment)@test.net"}},
+	}
+	err = ValidateStruct(invalid)
+	var ve ValidationErrors
+	if !errors.As(err, &ve) {
+		t.Errorf("we are expecting ValidationErrors, however we got :%v", err)
+		t.FailNow()
+	}
+	expected := []string{
+		"Primary: example.com: domain has no MX record",
+		"Secondary: domain part can't be empty",
+		"Parsed: quoted string and escape are not allowed by profile html5",
+		"Aliases[1]: comment is not allowed by profile html5",
+		`Others["work"]: an email address can't have multiple '@' characters`,
+		"Contacts[1].Email: empty string is not valid email address",
+		`Lookup["x"].Email: comment is not allowed by profile rfc5321`,
+	}
+	if len(ve) != len(expected) {
+		t.Errorf("we are expecting %d errors, however we got :%s", len(expected), ve)
+		t.FailNow()
+	}
+	for idx, fe := range ve {
+		if fe.Error() != expected[idx] {
+			t.Errorf("we are expecting err:%s,however we got :%s", expected[idx], fe)
+		}
+	}
+	if !errors.Is(ve[0], ErrNoMX) || !errors.Is(ve[5], ErrEmptyEmail) {
+		t.Errorf("we are expecting the underlying errors to be kept")
+	}
+}
+
+func TestValidateStructMisuse(t *testing.T) {
+	cases := []struct {
+		name  string
+		input interface{}
+		err   error
+	}{
+		{
+			name:  "nil",
+			input: nil,
+			err:   fmt.Errorf("ValidateStruct expects a struct, however got <nil>"),
+		},
+		{
+			name:  "not a struct",
+			input: "test@test.net",
+			err:   fmt.Errorf("ValidateStruct expects a struct, however got string"),
+		},
+		{
+			name: "unknown option",
+			input: struct {
+				Email string `emailaddress:"dns"`
+			}{},
+			err: fmt.Errorf("Email: invalid emailaddress tag: unknown option dns"),
+		},
+		{
+			name: "unknown profile",
+			input: struct {
+				Email string `emailaddress:"profile=loose"`
+			}{},
+			err: fmt.Errorf("Email: invalid emailaddress tag: unknown profile loose"),
+		},
+		{
+			name: "unsupported type",
+			input: struct {
+				Email int `emailaddress:"required"`
+			}{},
+			err: fmt.Errorf("Email: emailaddress tag is not supported on int"),
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			err := ValidateStruct(c.input)
+			if nil == err {
+				st.Errorf("we are expecting err:%s, however we got nil", c.err)
+				st.FailNow()
+			}
+			if c.err.Error() != err.Error() {
+				st.Errorf("we are expecting err:%s,however we got :%s", c.err, err)
+			}
+		})
+	}
+}
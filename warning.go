@@ -0,0 +1,144 @@
+package emailaddress
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// WarningCode classify why a valid email address is undesirable, the codes mirror the diagnoses of isemail
+type WarningCode string
+
+const (
+	// WarnComment the local part has a comment, which is not part of the mailbox (isemail CFWS_COMMENT)
+	WarnComment WarningCode = "cfws_comment"
+	// WarnQuotedString the local part has a quoted string, which many systems don't handle (isemail RFC5321_QUOTEDSTRING)
+	WarnQuotedString WarningCode = "rfc5321_quoted_string"
+	// WarnQuotedPair a character is escaped outside quoted string, which is obsolete syntax (isemail DEPREC_QP)
+	WarnQuotedPair WarningCode = "deprecated_quoted_pair"
+	// WarnObsoleteLocalPart the local part mix quoted strings and atoms, such as "john".smith (isemail DEPREC_LOCALPART)
+	WarnObsoleteLocalPart WarningCode = "deprecated_local_part"
+	// WarnTLD the domain has a single label, it can't be reached on the Internet (isemail RFC5321_TLD)
+	WarnTLD WarningCode = "rfc5321_tld"
+	// WarnNumericTLD the top level domain is numeric (isemail RFC5321_TLDNUMERIC)
+	WarnNumericTLD WarningCode = "rfc5321_tld_numeric"
+	// WarnDomainTrailingDot the domain end with a dot, which is valid in DNS but not in an address (isemail ERR_DOT_END)
+	WarnDomainTrailingDot WarningCode = "rfc5322_domain_trailing_dot"
+	// WarnUTF8LocalPart the local part has non-ASCII characters, it can only be delivered with SMTPUTF8 of RFC 6531
+	WarnUTF8LocalPart WarningCode = "rfc6531_utf8_local_part"
+	// WarnLocalTooLong the local part is longer than 64 octets, it is only accepted by a profile with a larger limit (isemail RFC5322_LOCAL_TOOLONG)
+	WarnLocalTooLong WarningCode = "rfc5322_local_too_long"
+	// WarnAddressLiteral the domain is an address literal, such as [192.0.2.1] (isemail RFC5321_ADDRESSLITERAL)
+	WarnAddressLiteral WarningCode = "rfc5321_address_literal"
+)
+
+// Warning is a diagnosis of a valid but undesirable email address
+type Warning struct {
+	// Code classify the warning
+	Code WarningCode `json:"code"`
+	// Offset is the byte offset in the input where the warning is found
+	Offset int `json:"offset"`
+	// Msg is the human readable description
+	Msg string `json:"message"`
+}
+
+// String stringer implementation
+func (w Warning) String() string {
+	return string(w.Code) + ": " + w.Msg
+}
+
+// Warnings return the diagnoses of the address, in the order of their offsets. It is nil when there is nothing undesirable.
+// A profile can turn selected warnings into errors, see Profile.WarningsAsErrors
+func (e *Address) Warnings() []Warning {
+	var warnings []Warning
+	local := e.raw[:len(e.raw)-len(e.domain)-1]
+	if len(unquote(e.lp.localPartEmail+e.lp.tagsText())) > MaxLocalPart {
+		warnings = append(warnings, Warning{Code: WarnLocalTooLong, Offset: 0, Msg: fmt.Sprintf("local part is longer than %d octets", MaxLocalPart)})
+	}
+	if len(e.lp.comment) > 0 {
+		warnings = append(warnings, Warning{Code: WarnComment, Offset: strings.IndexByte(local, '('), Msg: "comment is not part of the mailbox"})
+	}
+	quoted, quotedPair, atoms := scanQuoting(local)
+	if quoted > -1 {
+		warnings = append(warnings, Warning{Code: WarnQuotedString, Offset: quoted, Msg: "quoted string is not supported by many systems"})
+		if atoms {
+			warnings = append(warnings, Warning{Code: WarnObsoleteLocalPart, Offset: quoted, Msg: "quoted string mixed with atoms is obsolete"})
+		}
+	}
+	if quotedPair > -1 {
+		warnings = append(warnings, Warning{Code: WarnQuotedPair, Offset: quotedPair, Msg: "escape outside quoted string is obsolete"})
+	}
+	if offset := nonASCIIOffset(local); offset > -1 {
+		warnings = append(warnings, Warning{Code: WarnUTF8LocalPart, Offset: offset, Msg: "non-ASCII local part requires SMTPUTF8"})
+	}
+	domainStart := len(local) + 1
+	if e.dom.IsIP() {
+		warnings = append(warnings, Warning{Code: WarnAddressLiteral, Offset: domainStart, Msg: "address literal is not supported by many systems"})
+		return sortWarnings(warnings)
+	}
+	domain := e.domain
+	if strings.HasSuffix(domain, ".") {
+		warnings = append(warnings, Warning{Code: WarnDomainTrailingDot, Offset: len(e.raw) - 1, Msg: "domain should not end with a dot"})
+		domain = domain[:len(domain)-1]
+	}
+	tldStart := strings.LastIndexByte(domain, '.') + 1
+	if tldStart == 0 {
+		warnings = append(warnings, Warning{Code: WarnTLD, Offset: domainStart, Msg: "domain has no top level domain"})
+	} else if isNumeric(domain[tldStart:]) {
+		warnings = append(warnings, Warning{Code: WarnNumericTLD, Offset: domainStart + tldStart, Msg: "top level domain should not be numeric"})
+	}
+	return sortWarnings(warnings)
+}
+
+// sortWarnings sort the warnings by their offsets
+func sortWarnings(warnings []Warning) []Warning {
+	slices.SortStableFunc(warnings, func(a, b Warning) int {
+		return cmp.Compare(a.Offset, b.Offset)
+	})
+	return warnings
+}
+
+// scanQuoting return the offset of the first quoted string and the first escape outside quoted string in the local part,
+// -1 when there is none, and whether there are characters outside the quoted strings and the comment
+func scanQuoting(local string) (quoted int, quotedPair int, atoms bool) {
+	quoted, quotedPair = -1, -1
+	inQuotation, inComment, escaped := false, false, false
+	for i := 0; i < len(local); i++ {
+		c := local[i]
+		switch {
+		case escaped:
+			escaped = false
+		case c == byteEscape:
+			escaped = true
+			if !inQuotation && !inComment && quotedPair == -1 {
+				quotedPair = i
+			}
+		case inComment:
+			inComment = c != ')'
+		case c == '"':
+			inQuotation = !inQuotation
+			if quoted == -1 {
+				quoted = i
+			}
+		case inQuotation:
+		case c == '(':
+			inComment = true
+		default:
+			atoms = true
+		}
+		if !inQuotation && !inComment && escaped {
+			atoms = true
+		}
+	}
+	return quoted, quotedPair, atoms
+}
+
+func isNumeric(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return len(s) > 0
+}
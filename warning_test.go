@@ -0,0 +1,144 @@
+package emailaddress
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWarnings(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    string
+		expected []Warning
+	}{
+		{
+			name:     "no warning",
+			input:    "john.smith+tag@example.com",
+			expected: nil,
+		},
+		{
+			name:  "comment",
+			input: "john(comment)@example.com",
+			expected: []Warning{
+				{Code: WarnComment, Offset: 4, Msg: "comment is not part of the mailbox"},
+			},
+		},
+		{
+			name:  "quoted string",
+			input: `"john smith"@example.com`,
+			expected: []Warning{
+				{Code: WarnQuotedString, Offset: 0, Msg: "quoted string is not supported by many systems"},
+			},
+		},
+		{
+			name:  "obsolete local part",
+			input: `john."smith"@example.com`,
+			expected: []Warning{
+				{Code: WarnQuotedString, Offset: 5, Msg: "quoted string is not supported by many systems"},
+				{Code: WarnObsoleteLocalPart, Offset: 5, Msg: "quoted string mixed with atoms is obsolete"},
+			},
+		},
+		{
+			name:  "quoted pair",
+			input: `Abc\@def@example.com`,
+			expected: []Warning{
+				{Code: WarnQuotedPair, Offset: 3, Msg: "escape outside quoted string is obsolete"},
+			},
+		},
+		{
+			name:  "escape in quoted string is not a warning on its own",
+			input: `"we\"d"@example.com`,
+			expected: []Warning{
+				{Code: WarnQuotedString, Offset: 0, Msg: "quoted string is not supported by many systems"},
+			},
+		},
+		{
+			name:  "utf8 local part",
+			input: "jörg@example.com",
+			expected: []Warning{
+				{Code: WarnUTF8LocalPart, Offset: 1, Msg: "non-ASCII local part requires SMTPUTF8"},
+			},
+		},
+		{
+			name:  "single label domain",
+			input: "root@localhost",
+			expected: []Warning{
+				{Code: WarnTLD, Offset: 5, Msg: "domain has no top level domain"},
+			},
+		},
+		{
+			name:  "numeric top level domain and trailing dot",
+			input: "john@iana.123.",
+			expected: []Warning{
+				{Code: WarnNumericTLD, Offset: 10, Msg: "top level domain should not be numeric"},
+				{Code: WarnDomainTrailingDot, Offset: 13, Msg: "domain should not end with a dot"},
+			},
+		},
+		{
+			name:  "address literal",
+			input: "john@[192.0.2.1]",
+			expected: []Warning{
+				{Code: WarnAddressLiteral, Offset: 5, Msg: "address literal is not supported by many systems"},
+			},
+		},
+		{
+			name:  "ipv6 address literal",
+			input: "john(comment)@[IPv6:2001:db8::1]",
+			expected: []Warning{
+				{Code: WarnComment, Offset: 4, Msg: "comment is not part of the mailbox"},
+				{Code: WarnAddressLiteral, Offset: 14, Msg: "address literal is not supported by many systems"},
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(st *testing.T) {
+			e, err := Parse(c.input)
+			if nil != err {
+				st.Fatalf("we are not expecting error , however we got:%s", err)
+			}
+			if warnings := e.Warnings(); !reflect.DeepEqual(warnings, c.expected) {
+				st.Errorf("we are expecting %v , however we got : %v", c.expected, warnings)
+			}
+		})
+	}
+}
+
+func TestWarningsLocalTooLong(t *testing.T) {
+	profile := ProfileRFC5322
+	profile.Limits.LocalPart = 128
+	e, err := ParseWithProfile(strings.Repeat("a", 100)+"@example.com", profile)
+	if nil != err {
+		t.Fatalf("we are not expecting error , however we got:%s", err)
+	}
+	expected := []Warning{{Code: WarnLocalTooLong, Offset: 0, Msg: "local part is longer than 64 octets"}}
+	if warnings := e.Warnings(); !reflect.DeepEqual(warnings, expected) {
+		t.Errorf("we are expecting %v , however we got : %v", expected, warnings)
+	}
+	e, err = ParseWithProfile(`"`+strings.Repeat("a", 64)+`"(comment)@example.com`, profile)
+	if nil != err {
+		t.Fatalf("we are not expecting error , however we got:%s", err)
+	}
+	for _, w := range e.Warnings() {
+		if w.Code == WarnLocalTooLong {
+			t.Errorf("we are not expecting %s for 64 octets, however we got : %v", WarnLocalTooLong, w)
+		}
+	}
+}
+
+func TestWarningsAsErrors(t *testing.T) {
+	profile := ProfileRFC5322
+	profile.WarningsAsErrors = []WarningCode{WarnTLD, WarnQuotedPair}
+	if _, err := ParseWithProfile("john(comment)@example.com", profile); nil != err {
+		t.Errorf("we are not expecting error , however we got:%s", err)
+	}
+	_, err := ParseWithProfile("root@localhost", profile)
+	var pe *ParseError
+	if !errors.As(err, &pe) || pe.Code != ErrorCode(WarnTLD) || pe.Offset != 5 {
+		t.Errorf("we are expecting a %s error at 5, however we got : %v", WarnTLD, err)
+	}
+	if nil != err && err.Error() != "warning rfc5321_tld is an error in profile rfc5322: domain has no top level domain" {
+		t.Errorf("unexpected error message %s", err)
+	}
+}